@@ -0,0 +1,101 @@
+package objectid
+
+/*
+alloc.go implements the Allocator type, which mints subordinate OIDs.
+*/
+
+import (
+	"math/big"
+	"sync"
+)
+
+/*
+Allocator issues monotonically increasing subordinate arcs beneath a base
+OID, such as when minting OIDs for schema elements. Instances of this type
+are safe for concurrent use, and should be created using the [NewAllocator]
+function.
+*/
+type Allocator struct {
+	mu   sync.Mutex
+	base DotNotation
+	next *big.Int
+}
+
+/*
+NewAllocator returns a new instance of *[Allocator] bound to base, which
+can be a string or [DotNotation], alongside an error. A root arc alone
+(e.g.: "2") is permitted.
+
+Unless seeded using [Allocator.Seed], the first arc issued is one (1).
+*/
+func NewAllocator(base any) (a *Allocator, err error) {
+	key, ok := arcKey(base)
+	if !ok {
+		err = errorf("Invalid base for %T: %v", a, base)
+		return
+	}
+
+	d, _ := parseArcKey(key)
+	a = &Allocator{base: d, next: big.NewInt(1)}
+
+	return
+}
+
+/*
+Base returns a copy of the base [DotNotation] of the receiver.
+*/
+func (r *Allocator) Base() DotNotation {
+	return r.base.clone()
+}
+
+/*
+Seed advances the receiver beyond the greatest child of its base found
+within reg, such that no subsequent allocation collides with an existing
+registration. The receiver is never moved backwards.
+*/
+func (r *Allocator) Seed(reg *Registry) {
+	if reg == nil {
+		return
+	}
+
+	recs := reg.Records()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := 0; i < len(recs); i++ {
+		dot := recs[i].Dot
+		if dot.Len() != r.base.Len()+1 || dot.FirstDifference(r.base) != -1 {
+			continue
+		}
+
+		if leaf := dot.Leaf().cast(); leaf.Cmp(r.next) >= 0 {
+			r.next = big.NewInt(0).Add(leaf, big.NewInt(1))
+		}
+	}
+}
+
+/*
+NextChild returns a new instance of [DotNotation] bearing the next
+unissued subordinate arc of the receiver's base, alongside an error. An
+error is returned if the result does not satisfy [DotNotation.Validate],
+such as when the second-level arc limit (39) beneath itu-t(0) or iso(1)
+is exceeded.
+*/
+func (r *Allocator) NextChild() (dot DotNotation, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dot = make(DotNotation, r.base.Len()+1)
+	copy(dot, r.base)
+	dot[r.base.Len()] = NumberForm(*big.NewInt(0).Set(r.next))
+
+	if err = dot.Validate(); err != nil {
+		dot = nil
+		return
+	}
+
+	r.next.Add(r.next, big.NewInt(1))
+
+	return
+}
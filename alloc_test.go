@@ -0,0 +1,120 @@
+package objectid
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func ExampleAllocator_NextChild() {
+	alloc, err := NewAllocator(`1.3.6.1.4.1.56521.999`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for i := 0; i < 3; i++ {
+		dot, _ := alloc.NextChild()
+		fmt.Println(dot)
+	}
+	// Output:
+	// 1.3.6.1.4.1.56521.999.1
+	// 1.3.6.1.4.1.56521.999.2
+	// 1.3.6.1.4.1.56521.999.3
+}
+
+func ExampleAllocator_Seed() {
+	reg := NewRegistry()
+	for _, dot := range []string{`2.999.1`, `2.999.7`, `2.999.7.1000`, `2.998.50`} {
+		d, _ := NewDotNotation(dot)
+		reg.Register(Record{Dot: *d})
+	}
+
+	alloc, _ := NewAllocator(`2.999`)
+	alloc.Seed(reg)
+
+	dot, _ := alloc.NextChild()
+	fmt.Println(dot)
+	// Output: 2.999.8
+}
+
+func TestAllocator(t *testing.T) {
+	alloc, err := NewAllocator(`1.3.6.1.4.1.56521`)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	const workers, each = 8, 50
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		seen = make(map[string]bool)
+	)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < each; j++ {
+				dot, err := alloc.NextChild()
+				if err != nil {
+					t.Errorf("%s failed: %v", t.Name(), err)
+					return
+				}
+				mu.Lock()
+				seen[dot.String()] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(seen) != workers*each {
+		t.Errorf("%s failed: want %d unique allocations, got %d", t.Name(), workers*each, len(seen))
+	} else if !seen[`1.3.6.1.4.1.56521.400`] || seen[`1.3.6.1.4.1.56521.401`] {
+		t.Errorf("%s failed: allocations not sequential", t.Name())
+	}
+
+	// The test registry bears 1.3.6.1.4.1.56521.999.
+	reg := newTestRegistry(t)
+	alloc.Seed(reg)
+	if dot, _ := alloc.NextChild(); dot.String() != `1.3.6.1.4.1.56521.1000` {
+		t.Errorf("%s failed: bad seeded allocation: %s", t.Name(), dot)
+	}
+
+	// Seeding must never move the allocator backwards.
+	alloc.Seed(reg)
+	if dot, _ := alloc.NextChild(); dot.String() != `1.3.6.1.4.1.56521.1001` {
+		t.Errorf("%s failed: allocator moved backwards: %s", t.Name(), dot)
+	}
+
+	// Mutating the base copy must not affect the allocator.
+	base := alloc.Base()
+	base[0] = NumberForm{}
+	if dot, _ := alloc.NextChild(); dot.String() != `1.3.6.1.4.1.56521.1002` {
+		t.Errorf("%s failed: base was modified: %s", t.Name(), dot)
+	}
+}
+
+func TestAllocator_limits(t *testing.T) {
+	alloc, err := NewAllocator(`1`)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	for i := 1; i <= 39; i++ {
+		if _, err = alloc.NextChild(); err != nil {
+			t.Fatalf("%s failed at %d: %v", t.Name(), i, err)
+		}
+	}
+
+	if _, err = alloc.NextChild(); err == nil {
+		t.Errorf("%s failed: expected error beyond second-level arc limit", t.Name())
+	}
+
+	for _, bogus := range []any{``, `3`, `1..3`, 3.14} {
+		if _, err = NewAllocator(bogus); err == nil {
+			t.Errorf("%s failed: bogus base %v accepted", t.Name(), bogus)
+		}
+	}
+}
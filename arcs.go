@@ -0,0 +1,156 @@
+package objectid
+
+/*
+arcs.go contains standard root and second-level arc values.
+*/
+
+/*
+Root arcs, as defined in ITU-T Rec. X.660. These values are provided for
+construction and display, and must not be modified. Code which branches
+upon the root arc of a value should use [RootArc] instead.
+*/
+var (
+	ITUT         NameAndNumberForm = mustNaNF(`itu-t(0)`)
+	ISO          NameAndNumberForm = mustNaNF(`iso(1)`)
+	JointISOITUT NameAndNumberForm = mustNaNF(`joint-iso-itu-t(2)`)
+)
+
+/*
+RootArc describes one (1) of the three (3) root arcs defined in ITU-T Rec.
+X.660. This is the canonical means of branching upon the root arc of a
+value: obtain it using the [DotNotation.RootArc] method, and compare it
+against the RootArc constants rather than raw literals or the [ITUT],
+[ISO] and [JointISOITUT] variables.
+*/
+type RootArc uint8
+
+const (
+	RootITUT         RootArc = iota // itu-t(0)
+	RootISO                         // iso(1)
+	RootJointISOITUT                // joint-iso-itu-t(2)
+)
+
+var rootArcIdentifiers = [...]string{
+	RootITUT:         `itu-t`,
+	RootISO:          `iso`,
+	RootJointISOITUT: `joint-iso-itu-t`,
+}
+
+/*
+RootArcs returns all [RootArc] values in ascending numerical order.
+*/
+func RootArcs() []RootArc {
+	return []RootArc{RootITUT, RootISO, RootJointISOITUT}
+}
+
+/*
+Valid returns a Boolean value indicative of whether the receiver is a
+defined [RootArc].
+*/
+func (r RootArc) Valid() bool {
+	return r <= RootJointISOITUT
+}
+
+/*
+NumberForm returns the [NumberForm] of the receiver. A zero instance is
+returned if the receiver is invalid.
+*/
+func (r RootArc) NumberForm() (nf NumberForm) {
+	if r.Valid() {
+		nf, _ = NewNumberForm(uint(r))
+	}
+
+	return
+}
+
+/*
+NameAndNumberForm returns the [NameAndNumberForm] of the receiver (e.g.:
+"iso(1)"). The returned instance is independent of the [ITUT], [ISO] and
+[JointISOITUT] variables. A zero instance is returned if the receiver is
+invalid.
+*/
+func (r RootArc) NameAndNumberForm() (nanf NameAndNumberForm) {
+	if r.Valid() {
+		nanf = NameAndNumberForm{
+			identifier:        rootArcIdentifiers[r],
+			primaryIdentifier: r.NumberForm(),
+			parsed:            true,
+		}
+	}
+
+	return
+}
+
+/*
+String returns the ASN.1 identifier of the receiver (e.g.: "iso").
+*/
+func (r RootArc) String() (s string) {
+	if r.Valid() {
+		s = rootArcIdentifiers[r]
+	}
+
+	return
+}
+
+/*
+RootArc returns the [RootArc] of the receiver, alongside a Boolean value
+indicative of success.
+*/
+func (r DotNotation) RootArc() (root RootArc, ok bool) {
+	if r.Len() > 0 {
+		if ok = r[0].Lt(3) && r[0].cast().Sign() >= 0; ok {
+			root = RootArc(r[0].cast().Uint64())
+		}
+	}
+
+	return
+}
+
+/*
+Second-level arcs beneath itu-t(0).
+*/
+var (
+	ITUTRecommendation         NameAndNumberForm = mustNaNF(`recommendation(0)`)
+	ITUTQuestion               NameAndNumberForm = mustNaNF(`question(1)`)
+	ITUTAdministration         NameAndNumberForm = mustNaNF(`administration(2)`)
+	ITUTNetworkOperator        NameAndNumberForm = mustNaNF(`network-operator(3)`)
+	ITUTIdentifiedOrganization NameAndNumberForm = mustNaNF(`identified-organization(4)`)
+)
+
+/*
+Second-level arcs beneath iso(1).
+*/
+var (
+	ISOStandard               NameAndNumberForm = mustNaNF(`standard(0)`)
+	ISORegistrationAuthority  NameAndNumberForm = mustNaNF(`registration-authority(1)`)
+	ISOMemberBody             NameAndNumberForm = mustNaNF(`member-body(2)`)
+	ISOIdentifiedOrganization NameAndNumberForm = mustNaNF(`identified-organization(3)`)
+)
+
+/*
+Second-level arcs beneath joint-iso-itu-t(2).
+*/
+var (
+	JointASN1                       NameAndNumberForm = mustNaNF(`asn1(1)`)
+	JointDirectory                  NameAndNumberForm = mustNaNF(`ds(5)`)
+	JointCountry                    NameAndNumberForm = mustNaNF(`country(16)`)
+	JointRegistrationProcedures     NameAndNumberForm = mustNaNF(`registration-procedures(17)`)
+	JointInternationalOrganizations NameAndNumberForm = mustNaNF(`international-organizations(23)`)
+	JointUUID                       NameAndNumberForm = mustNaNF(`uuid(25)`)
+	JointTagBased                   NameAndNumberForm = mustNaNF(`tag-based(27)`)
+	JointExample                    NameAndNumberForm = mustNaNF(`example(999)`)
+)
+
+/*
+mustNaNF returns an instance of [NameAndNumberForm] parsed from x, and
+panics if x is invalid. This function is intended solely for use with
+package-level values known to be valid.
+*/
+func mustNaNF(x string) NameAndNumberForm {
+	nanf, err := NewNameAndNumberForm(x)
+	if err != nil {
+		panic(err)
+	}
+
+	return *nanf
+}
@@ -0,0 +1,100 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleISOIdentifiedOrganization() {
+	asn, _ := NewASN1Notation([]NameAndNumberForm{ISO, ISOIdentifiedOrganization})
+	fmt.Printf("%s", asn)
+	// Output: {iso(1) identified-organization(3)}
+}
+
+func TestStandardArcs(t *testing.T) {
+	for want, nanf := range map[string]NameAndNumberForm{
+		`itu-t(0)`:                        ITUT,
+		`iso(1)`:                          ISO,
+		`joint-iso-itu-t(2)`:              JointISOITUT,
+		`recommendation(0)`:               ITUTRecommendation,
+		`question(1)`:                     ITUTQuestion,
+		`administration(2)`:               ITUTAdministration,
+		`network-operator(3)`:             ITUTNetworkOperator,
+		`identified-organization(4)`:      ITUTIdentifiedOrganization,
+		`standard(0)`:                     ISOStandard,
+		`registration-authority(1)`:       ISORegistrationAuthority,
+		`member-body(2)`:                  ISOMemberBody,
+		`identified-organization(3)`:      ISOIdentifiedOrganization,
+		`asn1(1)`:                         JointASN1,
+		`ds(5)`:                           JointDirectory,
+		`country(16)`:                     JointCountry,
+		`registration-procedures(17)`:     JointRegistrationProcedures,
+		`international-organizations(23)`: JointInternationalOrganizations,
+		`uuid(25)`:                        JointUUID,
+		`tag-based(27)`:                   JointTagBased,
+		`example(999)`:                    JointExample,
+	} {
+		if got := nanf.String(); got != want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		} else if nanf.IsZero() {
+			t.Errorf("%s failed: %s is unparsed", t.Name(), want)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("%s failed: expected panic, got nothing", t.Name())
+		}
+	}()
+	_ = mustNaNF(`Bogus(1)`)
+}
+
+func ExampleRootArc() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	if root, ok := dot.RootArc(); ok && root == RootISO {
+		fmt.Println(root.NameAndNumberForm())
+	}
+	// Output: iso(1)
+}
+
+func TestRootArcs(t *testing.T) {
+	roots := RootArcs()
+	if len(roots) != 3 {
+		t.Fatalf("%s failed: want 3 root arcs, got %d", t.Name(), len(roots))
+	}
+
+	for i, root := range roots {
+		if !root.Valid() || !root.NumberForm().Equal(i) || !root.NameAndNumberForm().NumberForm().Equal(i) {
+			t.Errorf("%s failed: bad root arc %d", t.Name(), i)
+		}
+
+		dot, _ := NewDotNotation(sprintf("%d.1", i))
+		if r, ok := dot.RootArc(); !ok || r != root {
+			t.Errorf("%s failed: want %s, got %s", t.Name(), root, r)
+		}
+	}
+
+	if names := RootITUT.String() + ` ` + RootISO.String() + ` ` + RootJointISOITUT.String(); names != `itu-t iso joint-iso-itu-t` {
+		t.Errorf("%s failed: bad names '%s'", t.Name(), names)
+	}
+
+	// Each call must yield storage independent of the package-wide
+	// root arc variables.
+	nanf := RootISO.NameAndNumberForm()
+	if nanf.String() != ISO.String() {
+		t.Errorf("%s failed: want %s, got %s", t.Name(), ISO, nanf)
+	}
+	nanf.primaryIdentifier.cast().SetInt64(7)
+	if ISO.String() != `iso(1)` || RootISO.NameAndNumberForm().String() != `iso(1)` {
+		t.Errorf("%s failed: root arc storage is shared", t.Name())
+	}
+
+	bogus := RootArc(3)
+	if bogus.Valid() || bogus.String() != `` || !bogus.NameAndNumberForm().IsZero() {
+		t.Errorf("%s failed: bogus root arc misreported", t.Name())
+	}
+
+	if _, ok := (DotNotation{}).RootArc(); ok {
+		t.Errorf("%s failed: zero instance yielded root arc", t.Name())
+	}
+}
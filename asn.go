@@ -1,5 +1,7 @@
 package objectid
 
+import "math/big"
+
 /*
 asn.go handles ASN1Notation operations. For object
 identifier encoding/decoding, see dot.go.
@@ -121,6 +123,21 @@ Valid input forms for ASN.1 values are:
   - string slices (e.g.: []string{"iso(1)", "identified-organization(3)" ...})
   - [NameAndNumberForm] slices ([][NameAndNumberForm]{...})
 
+Alternatively, variadic input allows for mixtures of the following types,
+each treated as an individual [NameAndNumberForm] (arc):
+
+  - [NameAndNumberForm] (or a pointer thereto)
+  - string (e.g.: "dod(6)" or "6")
+  - [NumberForm]
+  - *[math/big.Int]
+  - uint64
+  - uint
+  - int
+
+For example:
+
+	NewASN1Notation(`iso`, `identified-organization(3)`, 6, nf)
+
 Note that the following identifier-only root nodes are also supported:
 
   - `itu-t` resolves to itu-t(0)
@@ -133,7 +150,57 @@ instance other than as the respective root node.
 
 [NumberForm] values CANNOT be negative, but are unbounded in their magnitude.
 */
-func NewASN1Notation(x any) (r *ASN1Notation, err error) {
+func NewASN1Notation(x ...any) (r *ASN1Notation, err error) {
+	if len(x) == 1 {
+		switch tv := x[0].(type) {
+		case string, []string, []NameAndNumberForm:
+			r, err = newASN1Notation(tv)
+			return
+		}
+	}
+
+	t := make(ASN1Notation, 0, len(x))
+	r = new(ASN1Notation)
+	for i := 0; i < len(x); i++ {
+		var nanf NameAndNumberForm
+		if nanf, err = assertNaNFArc(x[i]); err != nil {
+			return
+		}
+		t = append(t, nanf)
+	}
+
+	if err = t.Validate(); err == nil {
+		*r = t
+	}
+
+	return
+}
+
+/*
+assertNaNFArc returns an instance of [NameAndNumberForm] based upon x,
+which represents a single arc, alongside an error.
+*/
+func assertNaNFArc(x any) (nanf NameAndNumberForm, err error) {
+	switch tv := x.(type) {
+	case NameAndNumberForm:
+		nanf = tv
+	case *NameAndNumberForm:
+		if tv == nil {
+			err = errorf("Nil %T arc", tv)
+			break
+		}
+		nanf = *tv
+	default:
+		var n *NameAndNumberForm
+		if n, err = NewNameAndNumberForm(tv); err == nil {
+			nanf = *n
+		}
+	}
+
+	return
+}
+
+func newASN1Notation(x any) (r *ASN1Notation, err error) {
 	// prepare temporary instance
 	t := make(ASN1Notation, 0)
 	r = new(ASN1Notation)
@@ -142,8 +209,7 @@ func NewASN1Notation(x any) (r *ASN1Notation, err error) {
 	switch tv := x.(type) {
 	case []NameAndNumberForm:
 		t = ASN1Notation(tv)
-		if !t.Valid() {
-			err = errorf("%T instance did not pass validity checks: %#v", t, t)
+		if err = t.Validate(); err != nil {
 			break
 		}
 		*r = t
@@ -197,6 +263,50 @@ func (r ASN1Notation) Valid() (is bool) {
 	return
 }
 
+/*
+Validate returns an error describing the first rule violated by the
+receiver, or nil if no violation is found. The following conditions
+are reported:
+
+  - Receiver is zero length
+  - A [NameAndNumberForm] slice member was not produced by a constructor
+  - A [NameAndNumberForm] slice member bears a negative [NumberForm]
+  - A [NameAndNumberForm] slice member bears an invalid identifier
+  - The root [NumberForm] is greater than two (2)
+
+This method is particularly useful when an instance is assembled from
+a manually constructed [NameAndNumberForm] slice.
+*/
+func (r ASN1Notation) Validate() (err error) {
+	if r.Len() == 0 {
+		err = errorf("%T is zero length", r)
+		return
+	}
+
+	for i := 0; i < r.Len(); i++ {
+		nanf := r[i]
+		if !nanf.parsed {
+			err = errorf("%T arc %d was not properly initialized", r, i)
+		} else if nanf.primaryIdentifier.cast().Sign() < 0 {
+			err = errorf("%T arc %d bears a negative NumberForm (%s)",
+				r, i, nanf.primaryIdentifier)
+		} else if id := nanf.identifier; len(id) > 0 && !isIdentifier(id) {
+			err = errorf("%T arc %d bears an invalid identifier '%s'", r, i, id)
+		}
+
+		if err != nil {
+			return
+		}
+	}
+
+	if r[0].primaryIdentifier.cast().Cmp(big.NewInt(2)) > 0 {
+		err = errorf("%T root arc must be 0, 1 or 2; found %s",
+			r, r[0].primaryIdentifier)
+	}
+
+	return
+}
+
 /*
 Ancestry returns slices of [DotNotation] values ordered from leaf node
 (first) to root node (last).
@@ -207,13 +317,34 @@ within the receiver is less than two (2) [NumberForm] values in length.
 func (r ASN1Notation) Ancestry() (anc []ASN1Notation) {
 	if r.Len() >= 2 {
 		for i := r.Len(); i > 0; i-- {
-			anc = append(anc, r[:i])
+			anc = append(anc, r[:i:i])
 		}
 	}
 
 	return
 }
 
+/*
+AncestryFunc calls fn with each ancestral [ASN1Notation] of the receiver,
+ordered from leaf node (first) to root node (last), in the same manner as
+the [ASN1Notation.Ancestry] method. Iteration ceases once fn returns false.
+
+Unlike [ASN1Notation.Ancestry], no intermediate slices are materialized:
+each value passed to fn shares the receiver's underlying array, and should
+be copied if retained beyond the call.
+*/
+func (r ASN1Notation) AncestryFunc(fn func(ASN1Notation) bool) {
+	if r.Len() < 2 || fn == nil {
+		return
+	}
+
+	for i := r.Len(); i > 0; i-- {
+		if !fn(r[:i:i]) {
+			return
+		}
+	}
+}
+
 /*
 NewSubordinate returns a new instance of [ASN1Notation] based upon the
 contents of the receiver as well as the input [NameAndNumberForm]
@@ -305,6 +436,30 @@ func (r ASN1Notation) matchASN1(asn *ASN1Notation, off int) (matched bool) {
 	return ct == L
 }
 
+/*
+Equal returns a Boolean value indicative of whether the receiver and x
+describe the same OID, as determined by their numeric arcs alone. Names
+are not significant. See [DotNotation.Equal] for valid input types.
+*/
+func (r ASN1Notation) Equal(x any) bool {
+	return equalArcs(r.arcs(), numericArcs(x))
+}
+
+/*
+arcs returns the [NumberForm] values of the receiver as a [DotNotation].
+Unlike the [ASN1Notation.Dot] method, no minimum length is imposed.
+*/
+func (r ASN1Notation) arcs() (d DotNotation) {
+	if r.Len() > 0 {
+		d = make(DotNotation, r.Len())
+		for i := 0; i < r.Len(); i++ {
+			d[i] = r[i].NumberForm()
+		}
+	}
+
+	return
+}
+
 func assertASN1Notation(asn any) (A *ASN1Notation) {
 	switch tv := asn.(type) {
 	case string:
@@ -321,3 +476,56 @@ func assertASN1Notation(asn any) (A *ASN1Notation) {
 
 	return
 }
+
+/*
+UnnamedArcPolicy describes the manner in which arcs lacking an identifier
+are handled by the [ASN1Notation.Names] method.
+*/
+type UnnamedArcPolicy uint8
+
+const (
+	SkipUnnamed   UnnamedArcPolicy = iota // omit unnamed arcs
+	NumberUnnamed                         // render unnamed arcs by their NumberForm
+	RejectUnnamed                         // return an error upon any unnamed arc
+)
+
+/*
+Names returns the identifier-only, space-delimited rendering of the
+receiver (e.g.: "iso identified-organization dod internet"), which is
+useful for human-facing labels. Arcs which lack an identifier are first
+supplemented using the package-wide name dictionary, as with the
+[ASN1Notation.Tree] method. Any arcs which remain unnamed are handled
+according to policy.
+*/
+func (r ASN1Notation) Names(policy UnnamedArcPolicy) (names string, err error) {
+	if r.IsZero() {
+		err = errorf("%T is zero length", r)
+		return
+	}
+
+	var x []string
+	named := r.named()
+	for i := 0; i < len(named); i++ {
+		nanf := named[i]
+		if id := nanf.Identifier(); len(id) > 0 {
+			x = append(x, id)
+			continue
+		}
+
+		switch policy {
+		case SkipUnnamed:
+		case NumberUnnamed:
+			x = append(x, nanf.NumberForm().String())
+		case RejectUnnamed:
+			err = errorf("%T arc %d (%s) is unnamed", r, i, nanf.NumberForm())
+			return
+		default:
+			err = errorf("Unknown %T %d", policy, policy)
+			return
+		}
+	}
+
+	names = join(x, ` `)
+
+	return
+}
@@ -319,3 +319,147 @@ func TestASN1Notation_SiblingOf(t *testing.T) {
 		}
 	}
 }
+
+func TestASN1Notation_Validate(t *testing.T) {
+	var bogus ASN1Notation
+	if err := bogus.Validate(); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+
+	iso, _ := NewNameAndNumberForm(`iso(1)`)
+	bad, _ := NewNameAndNumberForm(3)
+	for idx, slice := range []ASN1Notation{
+		{*iso, {identifier: `Bogus-`, primaryIdentifier: iso.primaryIdentifier, parsed: true}},
+		{*iso, {primaryIdentifier: iso.primaryIdentifier}},
+		{*bad, *iso},
+	} {
+		if err := slice.Validate(); err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nothing", t.Name(), idx)
+		}
+		if _, err := NewASN1Notation([]NameAndNumberForm(slice)); err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nothing", t.Name(), idx)
+		}
+	}
+
+	asn, _ := NewASN1Notation(`{iso(1) identified-organization(3) 6}`)
+	if err := asn.Validate(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	}
+}
+
+func ExampleASN1Notation_Names() {
+	a, err := NewASN1Notation(`{iso(1) identified-organization(3) 6 1 4 1 56521}`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	names, _ := a.Names(NumberUnnamed)
+	fmt.Println(names)
+	// Output: iso identified-organization dod internet private enterprise 56521
+}
+
+func TestASN1Notation_Names(t *testing.T) {
+	a, _ := NewASN1Notation(`{iso(1) identified-organization(3) 6 1 4 1 56521}`)
+	for policy, want := range map[UnnamedArcPolicy]string{
+		SkipUnnamed:   `iso identified-organization dod internet private enterprise`,
+		NumberUnnamed: `iso identified-organization dod internet private enterprise 56521`,
+	} {
+		if got, err := a.Names(policy); err != nil || got != want {
+			t.Errorf("%s failed: want '%s', got '%s' (%v)", t.Name(), want, got, err)
+		}
+	}
+
+	if _, err := a.Names(RejectUnnamed); err == nil {
+		t.Errorf("%s failed: expected error for unnamed arc", t.Name())
+	}
+
+	b, _ := NewASN1Notation(`{joint-iso-itu-t(2) example(999)}`)
+	if got, err := b.Names(RejectUnnamed); err != nil || got != `joint-iso-itu-t example` {
+		t.Errorf("%s failed: got '%s' (%v)", t.Name(), got, err)
+	}
+
+	if _, err := (ASN1Notation{}).Names(SkipUnnamed); err == nil {
+		t.Errorf("%s failed: expected error for zero instance", t.Name())
+	}
+}
+
+func ExampleASN1Notation_AncestryFunc() {
+	a, _ := NewASN1Notation(`{iso(1) identified-organization(3) dod(6) internet(1)}`)
+	a.AncestryFunc(func(anc ASN1Notation) bool {
+		fmt.Println(anc)
+		return anc.Len() > 2 // stop at identified-organization
+	})
+	// Output:
+	// {iso(1) identified-organization(3) dod(6) internet(1)}
+	// {iso(1) identified-organization(3) dod(6)}
+	// {iso(1) identified-organization(3)}
+}
+
+func TestASN1Notation_AncestryFunc(t *testing.T) {
+	a, _ := NewASN1Notation(`{joint-iso-itu-t(2) example(999) 1 2 3}`)
+	want := a.Ancestry()
+
+	var got []ASN1Notation
+	a.AncestryFunc(func(anc ASN1Notation) bool {
+		got = append(got, anc)
+		return true
+	})
+
+	if len(got) != len(want) {
+		t.Fatalf("%s failed: want %d ancestors, got %d", t.Name(), len(want), len(got))
+	}
+	for i := 0; i < len(got); i++ {
+		if got[i].String() != want[i].String() {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want[i], got[i])
+		}
+	}
+
+	var calls int
+	(ASN1Notation{}).AncestryFunc(func(ASN1Notation) bool { calls++; return true })
+	a.AncestryFunc(nil)
+	if calls != 0 {
+		t.Errorf("%s failed: callback invoked for zero instance", t.Name())
+	}
+}
+
+/*
+This example demonstrates the creation of an [ASN1Notation] from variadic
+input comprised of mixed (supported) type instances.
+*/
+func ExampleNewASN1Notation_mixed() {
+	nf, _ := NewNumberForm(56521)
+	a, err := NewASN1Notation(`iso`, `identified-organization(3)`, `dod(6)`, 1, uint64(4), uint(1), nf)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(a)
+	// Output: {iso(1) identified-organization(3) dod(6) 1 4 1 56521}
+}
+
+func TestNewASN1Notation_mixed(t *testing.T) {
+	example, _ := NewNameAndNumberForm(`example(999)`)
+	a, err := NewASN1Notation(`joint-iso-itu-t(2)`, *example, example, big.NewInt(7))
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if got := a.Dot().String(); got != `2.999.999.7` {
+		t.Errorf("%s failed: want '2.999.999.7', got '%s'", t.Name(), got)
+	}
+
+	var nilNaNF *NameAndNumberForm
+	for _, bogus := range [][]any{
+		nil,
+		{3, 1},
+		{1, -1},
+		{1, `Bad(1)`},
+		{1, nilNaNF},
+		{1, NameAndNumberForm{}},
+		{1, 3.14},
+	} {
+		if _, err = NewASN1Notation(bogus...); err == nil {
+			t.Errorf("%s failed: bogus input %v accepted", t.Name(), bogus)
+		}
+	}
+}
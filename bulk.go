@@ -0,0 +1,226 @@
+package objectid
+
+/*
+bulk.go implements the BulkParser type, which parses large batches of
+dot notation strings into a shared backing store.
+*/
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"math"
+	"math/big"
+)
+
+/*
+BulkParser parses batches of dot notation strings (e.g.: "1.3.6.1") into
+a single shared backing store of [NumberForm] values, recording the offset
+of each parsed OID within that store. This drastically reduces per-OID
+allocations when loading very large numbers of OIDs, such as from logs or
+database exports.
+
+Arcs which fit within a single machine word share a common word store,
+and therefore incur no allocation of their own. Larger arcs, such as
+those beneath 2.25, are parsed individually.
+
+Each [DotNotation] obtained through [BulkParser.Index] is a read-only view
+of the backing store, and remains valid for the lifetime of the receiver.
+
+Instances of this type should be created using the [NewBulkParser]
+function, and are not safe for concurrent use.
+*/
+type BulkParser struct {
+	cfg   *parseConfig
+	arcs  []NumberForm
+	words []big.Word
+	offs  []int
+}
+
+/*
+NewBulkParser returns a new instance of *[BulkParser]. Zero or more
+instances of [ParseOption] may be provided, and are applied to each
+string parsed, as with [NewDotNotation].
+*/
+func NewBulkParser(opts ...ParseOption) *BulkParser {
+	return &BulkParser{cfg: newParseConfig(opts...), offs: []int{0}}
+}
+
+/*
+Grow reserves space within the receiver for n additional OIDs, each
+assumed to bear approximately arcs arcs, such that subsequent calls of
+[BulkParser.Parse] need not enlarge the backing store.
+*/
+func (r *BulkParser) Grow(n, arcs int) {
+	if n <= 0 || arcs <= 0 {
+		return
+	}
+
+	if need := len(r.arcs) + n*arcs; need > cap(r.arcs) {
+		a := make([]NumberForm, len(r.arcs), need)
+		copy(a, r.arcs)
+		r.arcs = a
+	}
+
+	if need := len(r.words) + n*arcs; need > cap(r.words) {
+		// Previously issued arcs retain the old word store,
+		// which is never written to again.
+		r.words = make([]big.Word, 0, need)
+	}
+
+	if need := len(r.offs) + n; need > cap(r.offs) {
+		o := make([]int, len(r.offs), need)
+		copy(o, r.offs)
+		r.offs = o
+	}
+}
+
+/*
+Len returns the integer number of OIDs parsed by the receiver.
+*/
+func (r *BulkParser) Len() int {
+	return len(r.offs) - 1
+}
+
+/*
+Index returns the Nth parsed [DotNotation] from the receiver, alongside
+a Boolean value indicative of success. This method supports the use of
+negative indices, such that -1 refers to the most recently parsed OID.
+
+The return value shares the receiver's backing store, and must not be
+modified. Copy it into a new slice if a mutable instance is required.
+*/
+func (r *BulkParser) Index(idx int) (dot DotNotation, ok bool) {
+	L := r.Len()
+	if idx < 0 {
+		idx += L
+	}
+
+	if ok = 0 <= idx && idx < L; ok {
+		start, end := r.offs[idx], r.offs[idx+1]
+		dot = r.arcs[start:end:end]
+	}
+
+	return
+}
+
+/*
+Parse parses the dot notation string dot, appending the result to the
+receiver. The same spellings recognized by [NewDotNotation] are honored.
+An error is returned if dot is invalid, in which case the receiver is
+left unmodified.
+*/
+func (r *BulkParser) Parse(dot string) (err error) {
+	if r.cfg.whitespace {
+		dot = trimS(dot)
+	}
+
+	if dot, err = stripDotPrefix(dot); err != nil {
+		return
+	} else if dot, err = r.cfg.prepareDot(dot); err != nil {
+		return
+	} else if len(dot) == 0 {
+		err = errorf("Zero length OID")
+		return
+	}
+
+	start, words := len(r.arcs), len(r.words)
+	if err = r.scan(dot); err == nil {
+		end := len(r.arcs)
+		if err = DotNotation(r.arcs[start:end:end]).Validate(); err == nil {
+			r.offs = append(r.offs, end)
+			return
+		}
+	}
+
+	// Roll back any partially parsed arcs.
+	r.arcs, r.words = r.arcs[:start], r.words[:words]
+
+	return
+}
+
+/*
+ParseLines parses each non-empty line read from rd as a dot notation
+string, in the manner of [BulkParser.Parse]. Parsing ceases upon the
+first invalid line, and the returned error bears its line number. OIDs
+parsed prior to the error are retained by the receiver.
+*/
+func (r *BulkParser) ParseLines(rd io.Reader) error {
+	return r.ParseLinesContext(context.Background(), rd)
+}
+
+/*
+ParseLinesContext is the same as [BulkParser.ParseLines], except that
+parsing ceases with the error of ctx once ctx is done. OIDs parsed prior
+to cancellation are retained by the receiver.
+*/
+func (r *BulkParser) ParseLinesContext(ctx context.Context, rd io.Reader) (err error) {
+	sc := bufio.NewScanner(rd)
+	for line := 1; sc.Scan(); line++ {
+		if err = canceled(ctx); err != nil {
+			return
+		} else if text := trimS(sc.Text()); len(text) > 0 {
+			if err = r.Parse(text); err != nil {
+				err = errorf("Line %d: %s", line, err.Error())
+				return
+			}
+		}
+	}
+
+	err = sc.Err()
+
+	return
+}
+
+/*
+scan appends each arc of dot to the receiver's backing store.
+*/
+func (r *BulkParser) scan(dot string) (err error) {
+	var (
+		start    int
+		n        uint64
+		overflow bool
+	)
+
+	for i := 0; i <= len(dot); i++ {
+		if i == len(dot) || dot[i] == '.' {
+			arc := dot[start:i]
+			if len(arc) == 0 {
+				err = errorf("Invalid OID '%s': empty arc at position %d", dot, i)
+				return
+			} else if err = r.cfg.checkArc(arc); err != nil {
+				return
+			}
+
+			var x big.Int
+			if overflow {
+				x.SetString(arc, 10)
+			} else if w := big.Word(n); uint64(w) != n {
+				x.SetUint64(n)
+			} else if n > 0 {
+				r.words = append(r.words, w)
+				L := len(r.words)
+				x.SetBits(r.words[L-1 : L : L])
+			}
+			r.arcs = append(r.arcs, NumberForm(x))
+			start, n, overflow = i+1, 0, false
+			continue
+		}
+
+		c := dot[i]
+		if c < '0' || '9' < c {
+			err = errorf("Invalid OID '%s': disallowed character at position %d", dot, i)
+			return
+		}
+
+		if !overflow {
+			if n > (math.MaxUint64-9)/10 {
+				overflow = true
+			} else {
+				n = n*10 + uint64(c-'0')
+			}
+		}
+	}
+
+	return
+}
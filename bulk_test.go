@@ -0,0 +1,93 @@
+package objectid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func ExampleBulkParser() {
+	bp := NewBulkParser()
+	if err := bp.ParseLines(strings.NewReader("1.3.6.1\n\n2.25.987895962269883002155146617097157934\nurn:oid:2.999\n")); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for i := 0; i < bp.Len(); i++ {
+		dot, _ := bp.Index(i)
+		fmt.Println(dot)
+	}
+	// Output:
+	// 1.3.6.1
+	// 2.25.987895962269883002155146617097157934
+	// 2.999
+}
+
+func TestBulkParser(t *testing.T) {
+	bp := NewBulkParser(RejectLeadingZeros())
+	bp.Grow(4, 4)
+
+	for _, raw := range []string{`0.0`, `1.3.6.1.4.1.56521`, `2.999.0.18446744073709551615`} {
+		if err := bp.Parse(raw); err != nil {
+			t.Fatalf("%s failed: %v", t.Name(), err)
+		}
+	}
+
+	for _, bogus := range []string{``, `1.03.6`, `1..3`, `3.1`, `1.40`, `1.x`} {
+		if err := bp.Parse(bogus); err == nil {
+			t.Errorf("%s failed: no error for '%s'", t.Name(), bogus)
+		}
+	}
+
+	if bp.Len() != 3 {
+		t.Fatalf("%s failed: want 3 OIDs, got %d", t.Name(), bp.Len())
+	}
+
+	// Parse more values to force reallocation of
+	// the backing store, then verify earlier views.
+	for i := 0; i < 100; i++ {
+		_ = bp.Parse(sprintf("2.999.%d.%d", i, i+1))
+	}
+
+	for idx, want := range map[int]string{
+		0:   `0.0`,
+		1:   `1.3.6.1.4.1.56521`,
+		2:   `2.999.0.18446744073709551615`,
+		50:  `2.999.47.48`,
+		-1:  `2.999.99.100`,
+		103: ``,
+	} {
+		dot, ok := bp.Index(idx)
+		if got := dot.String(); got != want || ok != (want != ``) {
+			t.Errorf("%s failed: index %d want '%s', got '%s'", t.Name(), idx, want, got)
+		}
+	}
+
+	if err := bp.ParseLines(strings.NewReader("1.2\nbogus\n")); err == nil || !strings.HasPrefix(err.Error(), `Line 2:`) {
+		t.Errorf("%s failed: want line 2 error, got %v", t.Name(), err)
+	}
+}
+
+func TestBulkParser_allocs(t *testing.T) {
+	bp := NewBulkParser()
+	bp.Grow(200, 7)
+
+	n := testing.AllocsPerRun(100, func() { _ = bp.Parse(`1.3.6.1.4.1.56521`) })
+	if n > 0 {
+		t.Errorf("%s failed: want zero allocations per OID, got %.1f", t.Name(), n)
+	}
+}
+
+func TestBulkParser_ParseLinesContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	bp := NewBulkParser()
+	if err := bp.ParseLinesContext(ctx, strings.NewReader("1.3.6.1\n2.999\n")); !errors.Is(err, context.Canceled) {
+		t.Errorf("%s failed: want context.Canceled, got %v", t.Name(), err)
+	} else if bp.Len() != 0 {
+		t.Errorf("%s failed: want 0 OIDs, got %d", t.Name(), bp.Len())
+	}
+}
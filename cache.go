@@ -0,0 +1,117 @@
+package objectid
+
+/*
+cache.go implements the ParseCache type, a bounded cache of parsed
+DotNotation values.
+*/
+
+import "sync"
+
+/*
+ParseCache is a fixed-size, least-recently-used cache of [DotNotation]
+values keyed by their input strings. This is useful in servers which
+repeatedly parse the same handful of OIDs from incoming requests.
+
+Instances of this type are safe for concurrent use, and should be created
+using the [NewParseCache] function.
+*/
+type ParseCache struct {
+	mu     sync.Mutex
+	opts   []ParseOption
+	cache  *lruCache[DotNotation]
+	hits   uint64
+	misses uint64
+}
+
+/*
+ParseCacheStats contains the counters of a [ParseCache], as returned by
+the [ParseCache.Stats] method.
+*/
+type ParseCacheStats struct {
+	Hits   uint64 // lookups satisfied by the cache
+	Misses uint64 // lookups which required parsing
+	Len    int    // entries presently cached
+}
+
+/*
+NewParseCache returns a new instance of *[ParseCache] holding no more
+than size entries. A size below one (1) results in a size of one (1).
+
+Zero or more instances of [ParseOption] may be provided, and are applied
+to each string parsed, as with [NewDotNotation].
+*/
+func NewParseCache(size int, opts ...ParseOption) *ParseCache {
+	if size < 1 {
+		size = 1
+	}
+
+	return &ParseCache{
+		opts:  opts,
+		cache: newLRUCache[DotNotation](size),
+	}
+}
+
+/*
+Parse returns the [DotNotation] parsed from dot, alongside an error. A
+cached value is returned if available; otherwise dot is parsed in the
+manner of [NewDotNotation] and, if successful, cached, evicting the least
+recently used entry if the receiver is full. Errors are not cached.
+
+Each return value is an independent copy, and may be freely modified.
+*/
+func (r *ParseCache) Parse(dot string) (d DotNotation, err error) {
+	r.mu.Lock()
+	if cached, found := r.cache.get(dot); found {
+		r.hits++
+		opStats.cacheHits.Add(1)
+		d = cached.clone()
+		r.mu.Unlock()
+		return
+	}
+	r.misses++
+	opStats.cacheMisses.Add(1)
+	r.mu.Unlock()
+
+	// Parse without holding the lock, as
+	// large arcs may take some time.
+	var D *DotNotation
+	args := make([]any, 0, len(r.opts)+1)
+	args = append(args, dot)
+	for i := 0; i < len(r.opts); i++ {
+		args = append(args, r.opts[i])
+	}
+	if D, err = NewDotNotation(args...); err != nil {
+		return
+	}
+	d = D.clone()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Another goroutine may have cached dot meanwhile,
+	// in which case the equivalent value is replaced.
+	r.cache.put(dot, *D)
+
+	return
+}
+
+/*
+Stats returns an instance of [ParseCacheStats] describing the receiver.
+*/
+func (r *ParseCache) Stats() ParseCacheStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return ParseCacheStats{Hits: r.hits, Misses: r.misses, Len: r.cache.len()}
+}
+
+/*
+Reset removes all entries from the receiver, and zeroes its counters.
+*/
+func (r *ParseCache) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cache.reset()
+	r.hits, r.misses = 0, 0
+}
@@ -0,0 +1,73 @@
+package objectid
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func ExampleParseCache() {
+	cache := NewParseCache(128)
+	for i := 0; i < 3; i++ {
+		if _, err := cache.Parse(`1.3.6.1.4.1.56521`); err != nil {
+			fmt.Println(err)
+			return
+		}
+	}
+	fmt.Printf("%+v\n", cache.Stats())
+	// Output: {Hits:2 Misses:1 Len:1}
+}
+
+func TestParseCache(t *testing.T) {
+	cache := NewParseCache(2, RejectLeadingZeros())
+
+	a, _ := cache.Parse(`1.3.6`)
+	_, _ = cache.Parse(`2.999`)
+	_, _ = cache.Parse(`1.3.6`) // hit; 2.999 is now least recent
+	_, _ = cache.Parse(`2.25`)  // evicts 2.999
+
+	if _, err := cache.Parse(`1.03`); err == nil {
+		t.Errorf("%s failed: parse option not honored", t.Name())
+	}
+
+	if st := cache.Stats(); st.Hits != 1 || st.Misses != 4 || st.Len != 2 {
+		t.Errorf("%s failed: bad stats %+v", t.Name(), st)
+	}
+
+	_, _ = cache.Parse(`1.3.6`)
+	_, _ = cache.Parse(`2.999`)
+	if st := cache.Stats(); st.Hits != 2 || st.Misses != 5 {
+		t.Errorf("%s failed: bad LRU order %+v", t.Name(), st)
+	}
+
+	// Modifying a returned value must not affect the cache.
+	_ = a.SetIndex(-1, 7)
+	if b, _ := cache.Parse(`1.3.6`); b.String() != `1.3.6` {
+		t.Errorf("%s failed: cached value altered: %s", t.Name(), b)
+	}
+
+	cache.Reset()
+	if st := cache.Stats(); st != (ParseCacheStats{}) {
+		t.Errorf("%s failed: bad stats after reset %+v", t.Name(), st)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				_, _ = cache.Parse(sprintf("2.999.%d", (i+j)%4))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if st := cache.Stats(); st.Hits+st.Misses != 400 || st.Len != 2 {
+		t.Errorf("%s failed: bad concurrent stats %+v", t.Name(), st)
+	}
+
+	if NewParseCache(0).cache.size != 1 {
+		t.Errorf("%s failed: bad minimum size", t.Name())
+	}
+}
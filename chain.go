@@ -0,0 +1,165 @@
+package objectid
+
+/*
+chain.go implements the ResolverChain type, which combines the various
+sources of OID metadata into a single resolution facility.
+*/
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+/*
+ResolverChain resolves a [DotNotation] to a [Record] by consulting, in
+order:
+
+  - the local [Registry], if any
+  - the package-wide name dictionary (see [RegisterIdentifier]), with the
+    long name of the object name table (see [RegisterObjectName]) used
+    as the description, if known
+  - the [RemoteResolver], if any
+
+Results obtained from the remote resolver, including the absence of a
+record, are cached in a fixed-size, least-recently-used manner, as remote
+lookups are presumed slow. Local sources are always consulted anew, so
+that changes made to them take effect immediately.
+
+Instances of this type are safe for concurrent use, and should be created
+using the [NewResolverChain] function. [ResolverChain] itself satisfies the
+[RemoteResolver] interface, allowing chains to be nested.
+*/
+type ResolverChain struct {
+	reg    *Registry
+	remote RemoteResolver
+
+	mu    sync.Mutex
+	cache *lruCache[chainEntry]
+}
+
+type chainEntry struct {
+	rec   Record
+	found bool
+}
+
+/*
+DefaultResolverCacheSize is the number of remote results cached by a
+[ResolverChain] if no size is specified.
+*/
+const DefaultResolverCacheSize = 1024
+
+/*
+NewResolverChain returns a new instance of *[ResolverChain] consulting reg
+and remote, either of which may be nil, and caching up to size remote
+results. A size below one (1) results in [DefaultResolverCacheSize].
+*/
+func NewResolverChain(reg *Registry, remote RemoteResolver, size int) *ResolverChain {
+	if size < 1 {
+		size = DefaultResolverCacheSize
+	}
+
+	return &ResolverChain{
+		reg:    reg,
+		remote: remote,
+		cache:  newLRUCache[chainEntry](size),
+	}
+}
+
+/*
+Resolve returns the first [Record] found for dot amongst the sources of
+the receiver, alongside an error. An error wrapping [ErrNotFound] is
+returned if no source bears a record for dot. Errors returned by the
+remote resolver, other than [ErrNotFound], are returned as-is and are not
+cached.
+
+The returned record is an independent copy, and may be freely modified.
+*/
+func (r *ResolverChain) Resolve(ctx context.Context, dot DotNotation) (rec Record, err error) {
+	if dot.Len() == 0 {
+		err = errorf("Cannot resolve zero %T", dot)
+		return
+	}
+
+	var found bool
+	if r.reg != nil {
+		if rec, found = r.reg.Lookup(dot); found {
+			return
+		}
+	}
+
+	key := dot.String()
+	if rec, found = dictionaryRecord(dot, key); found {
+		return
+	} else if r.remote == nil {
+		err = notFound(key)
+		return
+	}
+
+	if entry, cached := r.cached(key); cached {
+		if !entry.found {
+			err = notFound(key)
+			return
+		}
+		rec = entry.rec
+		rec.Dot = rec.Dot.clone()
+		rec.Authority = rec.Authority.clone()
+		return
+	}
+
+	if rec, err = r.remote.Resolve(ctx, dot); err == nil {
+		entry := chainEntry{rec: rec, found: true}
+		entry.rec.Dot = dot.clone()
+		entry.rec.Authority = rec.Authority.clone()
+		rec.Dot = dot.clone()
+		r.store(key, entry)
+	} else if errors.Is(err, ErrNotFound) {
+		r.store(key, chainEntry{})
+	}
+
+	return
+}
+
+/*
+Purge removes all cached remote results from the receiver.
+*/
+func (r *ResolverChain) Purge() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cache.reset()
+}
+
+func (r *ResolverChain) cached(key string) (chainEntry, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cache.get(key)
+}
+
+func (r *ResolverChain) store(key string, entry chainEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cache.put(key, entry)
+}
+
+/*
+dictionaryRecord returns a [Record] for dot, whose dot notation is key,
+assembled from the package-wide name dictionary and object name table,
+alongside a Boolean value indicative of success.
+*/
+func dictionaryRecord(dot DotNotation, key string) (rec Record, found bool) {
+	rec.Identifier, found = lookupIdentifier(key)
+	if name, ok := LookupObjectName(key); ok {
+		rec.Description, found = name.Long, true
+	}
+
+	if found {
+		rec.Dot = dot.clone()
+	}
+
+	return
+}
+
+var _ RemoteResolver = (*ResolverChain)(nil)
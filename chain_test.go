@@ -0,0 +1,114 @@
+package objectid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+)
+
+type testResolver struct {
+	calls atomic.Int32
+	recs  map[string]string
+}
+
+func (r *testResolver) Resolve(_ context.Context, dot DotNotation) (rec Record, err error) {
+	r.calls.Add(1)
+	if desc, found := r.recs[dot.String()]; found {
+		rec = Record{Dot: dot, Description: desc, Authority: &RegistrationAuthority{Name: `remote`}}
+	} else if dot.String() == `2.999.666` {
+		err = errorf("Service unavailable")
+	} else {
+		err = notFound(dot.String())
+	}
+
+	return
+}
+
+func ExampleResolverChain_Resolve() {
+	reg := NewRegistry()
+	_ = reg.Register(Record{Dot: *MustNewDotNotation(`1.3.6.1.4.1.56521`), Identifier: `example`})
+
+	chain := NewResolverChain(reg, nil, 0)
+	for _, dot := range []string{`1.3.6.1.4.1.56521`, `2.5.4.3`, `2.999.1`} {
+		rec, err := chain.Resolve(context.Background(), *MustNewDotNotation(dot))
+		if errors.Is(err, ErrNotFound) {
+			fmt.Printf("%s: not found\n", dot)
+			continue
+		}
+		fmt.Printf("%s: %q %q\n", dot, rec.Identifier, rec.Description)
+	}
+	// Output:
+	// 1.3.6.1.4.1.56521: "example" ""
+	// 2.5.4.3: "" "commonName"
+	// 2.999.1: not found
+}
+
+func TestResolverChain(t *testing.T) {
+	remote := &testResolver{recs: map[string]string{`2.999.1`: `remote arc`}}
+	chain := NewResolverChain(newTestRegistry(t), remote, 2)
+	ctx := context.Background()
+
+	for dot, want := range map[string]string{
+		`1.3.6.1.4.1.56521.999`: `example`,
+		`1.3.6.1.5.5.7`:         `pkix`,
+	} {
+		if rec, err := chain.Resolve(ctx, *MustNewDotNotation(dot)); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if rec.Identifier != want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, rec.Identifier)
+		}
+	}
+	if n := remote.calls.Load(); n != 0 {
+		t.Errorf("%s failed: remote consulted %d times for local records", t.Name(), n)
+	}
+
+	dot := *MustNewDotNotation(`2.999.1`)
+	for i := 0; i < 3; i++ {
+		rec, err := chain.Resolve(ctx, dot)
+		if err != nil || rec.Description != `remote arc` || !rec.Dot.Equal(dot) ||
+			rec.Authority == nil || rec.Authority.Name != `remote` {
+			t.Errorf("%s failed: unexpected result %v, %v", t.Name(), rec, err)
+			continue
+		}
+		_ = rec.Dot.SetIndex(2, 5)
+		rec.Authority.Name = `modified`
+	}
+	missing := *MustNewDotNotation(`2.999.2`)
+	for i := 0; i < 3; i++ {
+		if _, err := chain.Resolve(ctx, missing); !errors.Is(err, ErrNotFound) {
+			t.Errorf("%s failed: want ErrNotFound, got %v", t.Name(), err)
+		}
+	}
+	if n := remote.calls.Load(); n != 2 {
+		t.Errorf("%s failed: want 2 remote calls, got %d", t.Name(), n)
+	}
+
+	// Transient errors are not cached.
+	for i := 0; i < 2; i++ {
+		if _, err := chain.Resolve(ctx, *MustNewDotNotation(`2.999.666`)); err == nil || errors.Is(err, ErrNotFound) {
+			t.Errorf("%s failed: unexpected error %v", t.Name(), err)
+		}
+	}
+	if n := remote.calls.Load(); n != 4 {
+		t.Errorf("%s failed: want 4 remote calls, got %d", t.Name(), n)
+	}
+
+	// Eviction of the least recently used entry (2.999.1).
+	_, _ = chain.Resolve(ctx, *MustNewDotNotation(`2.999.3`))
+	_, _ = chain.Resolve(ctx, dot)
+	if n := remote.calls.Load(); n != 6 {
+		t.Errorf("%s failed: want 6 remote calls, got %d", t.Name(), n)
+	}
+
+	chain.Purge()
+	_, _ = chain.Resolve(ctx, dot)
+	if n := remote.calls.Load(); n != 7 {
+		t.Errorf("%s failed: want 7 remote calls, got %d", t.Name(), n)
+	}
+
+	if _, err := NewResolverChain(nil, nil, 0).Resolve(ctx, nil); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+}
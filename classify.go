@@ -0,0 +1,233 @@
+package objectid
+
+/*
+classify.go implements the Classifier type, which matches DotNotation
+values against many Matcher patterns at once.
+*/
+
+/*
+MatchRule associates a name with a [Matcher] pattern, for use with a
+[Classifier]. The name is opaque to this package, and need not be unique.
+*/
+type MatchRule struct {
+	Name    string
+	Pattern string
+}
+
+/*
+Classifier is a set of [MatchRule] instances compiled into a single
+prefix tree, such that a [DotNotation] is classified against all rules
+in one pass rather than once per rule. This is suited to high-rate
+filtering, such as the dispatch of certificate extensions or SNMP traps.
+Instances of this type should be created using the [NewClassifier]
+function, and are safe for concurrent use once created.
+
+Patterns bear the syntax described by the [Matcher] type. Rules sharing
+leading arc expressions share the corresponding tree nodes, and patterns
+bearing a double asterisk are evaluated as an automaton over the tree,
+such that classification remains linear in the length of the input value.
+*/
+type Classifier struct {
+	rules []MatchRule
+	root  *classNode
+	nodes int
+}
+
+/*
+classNode is a single state within a compiled [Classifier]. Literal
+children are indexed by the decimal value of their arc, while children
+bearing ranges are scanned in order. A deep child consumes zero (0) or
+more arcs of any value, and therefore loops upon itself.
+*/
+type classNode struct {
+	id     int
+	rules  []int
+	lits   map[string]*classNode
+	ranged []classEdge
+	deep   *classNode
+	loops  bool
+}
+
+type classEdge struct {
+	key    string
+	ranges arcRanges
+	node   *classNode
+}
+
+/*
+NewClassifier returns an instance of *[Classifier] compiled from rules,
+alongside an error. An error is returned if any rule pattern is invalid.
+*/
+func NewClassifier(rules ...MatchRule) (r *Classifier, err error) {
+	c := &Classifier{
+		rules: make([]MatchRule, len(rules)),
+		root:  &classNode{},
+		nodes: 1,
+	}
+	copy(c.rules, rules)
+
+	for i := 0; i < len(c.rules); i++ {
+		var m *Matcher
+		if m, err = NewMatcher(c.rules[i].Pattern); err != nil {
+			err = errorf("Rule %d (%s): %v", i, c.rules[i].Name, err)
+			return
+		}
+
+		node := c.root
+		for j := 0; j < len(m.steps); j++ {
+			node = c.child(node, m.steps[j])
+		}
+		node.rules = append(node.rules, i)
+	}
+
+	r = c
+
+	return
+}
+
+/*
+child returns the child of node reached by step, creating it if needed.
+*/
+func (r *Classifier) child(node *classNode, step matchStep) (kid *classNode) {
+	if step.deep {
+		if kid = node.deep; kid == nil {
+			kid = r.newNode()
+			kid.loops = true
+			node.deep = kid
+		}
+		return
+	}
+
+	if rng := step.ranges; len(rng) == 1 && rng[0].hi != nil && rng[0].lo.Cmp(rng[0].hi) == 0 {
+		key := rng[0].lo.String()
+		if kid = node.lits[key]; kid == nil {
+			if node.lits == nil {
+				node.lits = make(map[string]*classNode)
+			}
+			kid = r.newNode()
+			node.lits[key] = kid
+		}
+		return
+	}
+
+	key := step.ranges.key()
+	for i := 0; i < len(node.ranged); i++ {
+		if node.ranged[i].key == key {
+			return node.ranged[i].node
+		}
+	}
+
+	kid = r.newNode()
+	node.ranged = append(node.ranged, classEdge{key: key, ranges: step.ranges, node: kid})
+
+	return
+}
+
+func (r *Classifier) newNode() *classNode {
+	r.nodes++
+	return &classNode{id: r.nodes - 1}
+}
+
+/*
+Rules returns a copy of the rules from which the receiver was compiled.
+*/
+func (r Classifier) Rules() []MatchRule {
+	rules := make([]MatchRule, len(r.rules))
+	copy(rules, r.rules)
+	return rules
+}
+
+/*
+Classify returns each [MatchRule] within the receiver whose pattern
+matches dot, which can be a string or [DotNotation], in the order in
+which the rules were supplied to [NewClassifier]. A nil slice is returned
+if no rule matches, or if dot is invalid.
+*/
+func (r Classifier) Classify(dot any) (matched []MatchRule) {
+	D := assertDotNot(dot)
+	if D == nil || r.root == nil {
+		return
+	}
+
+	seen := make([]int, r.nodes)
+	step := 1
+	cur := r.root.closure(nil, seen, step)
+
+	for i := 0; i < D.Len() && len(cur) > 0; i++ {
+		step++
+		arc := (*D)[i].cast()
+		key := arc.String()
+
+		var next []*classNode
+		for _, node := range cur {
+			if node.loops {
+				next = node.closure(next, seen, step)
+			}
+			if kid, found := node.lits[key]; found {
+				next = kid.closure(next, seen, step)
+			}
+			for j := 0; j < len(node.ranged); j++ {
+				if node.ranged[j].ranges.contains(arc) {
+					next = node.ranged[j].node.closure(next, seen, step)
+				}
+			}
+		}
+		cur = next
+	}
+
+	hits := make([]bool, len(r.rules))
+	var n int
+	for _, node := range cur {
+		for _, idx := range node.rules {
+			if !hits[idx] {
+				hits[idx] = true
+				n++
+			}
+		}
+	}
+
+	if n > 0 {
+		matched = make([]MatchRule, 0, n)
+		for i := 0; i < len(hits); i++ {
+			if hits[i] {
+				matched = append(matched, r.rules[i])
+			}
+		}
+	}
+
+	return
+}
+
+/*
+closure appends the receiver to active, alongside any deep descendants
+reachable without consuming an arc, skipping those already marked with
+step within seen.
+*/
+func (r *classNode) closure(active []*classNode, seen []int, step int) []*classNode {
+	for node := r; node != nil && seen[node.id] != step; node = node.deep {
+		seen[node.id] = step
+		active = append(active, node)
+	}
+
+	return active
+}
+
+/*
+key returns a canonical string representation of the receiver, used to
+share tree nodes between equivalent arc expressions.
+*/
+func (r arcRanges) key() string {
+	var b []byte
+	for i := 0; i < len(r); i++ {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = r[i].lo.Append(b, 10)
+		b = append(b, '-')
+		if r[i].hi != nil {
+			b = r[i].hi.Append(b, 10)
+		}
+	}
+
+	return string(b)
+}
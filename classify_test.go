@@ -0,0 +1,90 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleClassifier_Classify() {
+	c, err := NewClassifier(
+		MatchRule{Name: `pkix-kp`, Pattern: `1.3.6.1.5.5.7.3.*`},
+		MatchRule{Name: `server-auth`, Pattern: `1.3.6.1.5.5.7.3.1`},
+		MatchRule{Name: `x509-ext`, Pattern: `2.5.29.**`},
+	)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, rule := range c.Classify(`1.3.6.1.5.5.7.3.1`) {
+		fmt.Println(rule.Name)
+	}
+	// Output:
+	// pkix-kp
+	// server-auth
+}
+
+func TestClassifier(t *testing.T) {
+	patterns := []string{
+		`1.3.6.1.4.1.56521.999.[1-5]`,
+		`1.3.6.1.4.1.56521.999.3`,
+		`1.3.6.1.4.1.56521.999.[5-]`,
+		`1.3.6.1.4.1.**`,
+		`1.3.6.**.5`,
+		`1.3.**.**.[1-2]`,
+		`**.999.*`,
+		`2.999.**`,
+		`2.999.[1,3,10-20]`,
+		`2.999.[1,3,10-20]`,
+		`*.[3-5, 4-8 ,9]`,
+		`2.[100-].*`,
+	}
+
+	rules := make([]MatchRule, len(patterns))
+	matchers := make([]*Matcher, len(patterns))
+	for i, pattern := range patterns {
+		rules[i] = MatchRule{Name: fmt.Sprintf("rule%d", i), Pattern: pattern}
+		matchers[i], _ = NewMatcher(pattern)
+	}
+
+	c, err := NewClassifier(rules...)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if got := c.Rules(); len(got) != len(rules) {
+		t.Fatalf("%s failed: want %d rules, got %d", t.Name(), len(rules), len(got))
+	}
+
+	for _, dot := range []string{
+		`0.3`, `1.2`, `1.3`, `1.3.1`, `1.3.6`, `1.3.6.5`, `1.3.6.1.5`,
+		`1.3.6.1.4.1`, `1.3.6.1.4.1.2`, `1.3.6.1.4.1.5`,
+		`1.3.6.1.4.1.56521.999`, `1.3.6.1.4.1.56521.999.0`,
+		`1.3.6.1.4.1.56521.999.3`, `1.3.6.1.4.1.56521.999.5`,
+		`1.3.6.1.4.1.56521.999.7`, `1.3.6.1.4.1.56521.999.1.1`,
+		`2.8`, `2.99.1`, `2.100.0`, `2.999`, `2.999.1`, `2.999.2`,
+		`2.999.15`, `2.999.1.2.3`, `2.1000.340282366920938463463374607431768211456`,
+	} {
+		var want []string
+		for i := 0; i < len(matchers); i++ {
+			if matchers[i].Match(dot) {
+				want = append(want, rules[i].Name)
+			}
+		}
+
+		var got []string
+		for _, rule := range c.Classify(dot) {
+			got = append(got, rule.Name)
+		}
+
+		if fmt.Sprint(want) != fmt.Sprint(got) {
+			t.Errorf("%s failed for %s:\n\twant: %v\n\tgot:  %v", t.Name(), dot, want, got)
+		}
+	}
+
+	if got := c.Classify(`bogus`); got != nil {
+		t.Errorf("%s failed: expected no rules for invalid input, got %v", t.Name(), got)
+	}
+
+	if _, err = NewClassifier(MatchRule{Name: `bad`, Pattern: `1.[5-1]`}); err == nil {
+		t.Errorf("%s failed: expected error for invalid pattern", t.Name())
+	}
+}
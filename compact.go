@@ -0,0 +1,194 @@
+package objectid
+
+/*
+compact.go implements the CompactSet type, a frozen and memory-compact
+membership structure for very large collections of OIDs.
+*/
+
+import (
+	"bytes"
+	"sort"
+)
+
+/*
+CompactSet is an immutable set of OIDs, stored as the sorted contents
+octets of their ASN.1 encodings within a single shared buffer. This is
+far smaller than an equivalent collection of [DotNotation] values, and
+is intended for read-mostly workloads such as blocklists bearing many
+millions of OIDs.
+
+As each subidentifier within an encoding is self-delimiting, an OID is
+an ancestor of another if and only if its contents octets are a prefix
+of those of the other. Both exact and prefix queries are therefore
+answered by binary search.
+
+Instances of this type should be created using the [NewCompactSet]
+function, and are safe for concurrent use once created.
+*/
+type CompactSet struct {
+	buf  []byte
+	offs []uint32
+}
+
+/*
+NewCompactSet returns a new instance of *[CompactSet] containing each
+OID within oids, alongside an error. Valid input types are those
+supported by [DotNotation.Equal]. Duplicate values are stored but once.
+
+An error is returned upon the first value which cannot be parsed or
+encoded, such as a root arc alone.
+*/
+func NewCompactSet(oids ...any) (r *CompactSet, err error) {
+	cfg := newEncodingConfig()
+	contents := make([][]byte, len(oids))
+
+	var size int
+	for i := 0; i < len(oids); i++ {
+		var enc []byte
+		if enc, err = numericArcs(oids[i]).Encode(); err != nil {
+			err = errorf("Value %d (%v) cannot be encoded: %s", i, oids[i], err.Error())
+			return
+		} else if contents[i], _, err = readOIDTLV(enc, cfg); err != nil {
+			return
+		}
+		size += len(contents[i])
+	}
+
+	sort.Slice(contents, func(i, j int) bool {
+		return bytes.Compare(contents[i], contents[j]) < 0
+	})
+
+	r = &CompactSet{
+		buf:  make([]byte, 0, size),
+		offs: make([]uint32, 1, len(contents)+1),
+	}
+
+	for i := 0; i < len(contents); i++ {
+		if i > 0 && bytes.Equal(contents[i], contents[i-1]) {
+			continue
+		}
+		r.buf = append(r.buf, contents[i]...)
+		r.offs = append(r.offs, uint32(len(r.buf)))
+	}
+
+	return
+}
+
+/*
+Len returns the integer number of OIDs present within the receiver.
+*/
+func (r *CompactSet) Len() (l int) {
+	if r != nil {
+		l = len(r.offs) - 1
+	}
+
+	return
+}
+
+/*
+Size returns the integer number of bytes consumed by the receiver's
+contents octets and offsets, for use in capacity planning.
+*/
+func (r *CompactSet) Size() (s int) {
+	if r != nil {
+		s = len(r.buf) + 4*len(r.offs)
+	}
+
+	return
+}
+
+/*
+Contains returns a Boolean value indicative of whether dot is a member
+of the receiver. Valid input types are those supported by [DotNotation.Equal].
+*/
+func (r *CompactSet) Contains(dot any) (has bool) {
+	if c, ok := r.contents(dot); ok {
+		idx := r.search(c)
+		has = idx < r.Len() && bytes.Equal(r.member(idx), c)
+	}
+
+	return
+}
+
+/*
+HasPrefix returns a Boolean value indicative of whether any member of the
+receiver is equal to, or a descendant of, prefix. Valid input types are
+those supported by [DotNotation.Equal], and prefix must bear at least two
+(2) arcs.
+*/
+func (r *CompactSet) HasPrefix(prefix any) (has bool) {
+	if c, ok := r.contents(prefix); ok {
+		idx := r.search(c)
+		has = idx < r.Len() && bytes.HasPrefix(r.member(idx), c)
+	}
+
+	return
+}
+
+/*
+Covers returns a Boolean value indicative of whether dot, or any of its
+ancestors, is a member of the receiver. This is the natural query when
+each member denotes an entire subtree, as with a blocklist.
+*/
+func (r *CompactSet) Covers(dot any) (has bool) {
+	c, ok := r.contents(dot)
+	if !ok {
+		return
+	}
+
+	// Query each prefix of c which ends upon a
+	// subidentifier boundary (high bit clear).
+	for i := 0; i < len(c) && !has; i++ {
+		if c[i]&0x80 == 0 {
+			idx := r.search(c[:i+1])
+			has = idx < r.Len() && bytes.Equal(r.member(idx), c[:i+1])
+		}
+	}
+
+	return
+}
+
+/*
+DotNotation returns the Nth member of the receiver, in encoding order,
+alongside a Boolean value indicative of success.
+*/
+func (r *CompactSet) DotNotation(idx int) (dot DotNotation, ok bool) {
+	if 0 <= idx && idx < r.Len() {
+		var err error
+		dot, err = decodeContent(r.member(idx), newEncodingConfig())
+		ok = err == nil
+	}
+
+	return
+}
+
+func (r *CompactSet) member(idx int) []byte {
+	return r.buf[r.offs[idx]:r.offs[idx+1]]
+}
+
+/*
+search returns the index of the first member of the receiver which does
+not sort before c.
+*/
+func (r *CompactSet) search(c []byte) int {
+	return sort.Search(r.Len(), func(i int) bool {
+		return bytes.Compare(r.member(i), c) >= 0
+	})
+}
+
+/*
+contents returns the contents octets of the encoding of x, alongside a
+Boolean value indicative of success.
+*/
+func (r *CompactSet) contents(x any) (c []byte, ok bool) {
+	if r.Len() == 0 {
+		return
+	}
+
+	if enc, err := numericArcs(x).Encode(); err == nil {
+		c, _, err = readOIDTLV(enc, newEncodingConfig())
+		ok = err == nil
+	}
+
+	return
+}
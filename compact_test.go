@@ -0,0 +1,96 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleCompactSet_Covers() {
+	blocked, _ := NewCompactSet(`1.3.6.1.4.1.56521`, `2.999`)
+	fmt.Println(blocked.Covers(`1.3.6.1.4.1.56521.1.5`), blocked.Covers(`1.3.6.1.4.1.56522`))
+	// Output: true false
+}
+
+func TestCompactSet(t *testing.T) {
+	dot, _ := NewDotNotation(`2.25.987895962269883002155146617097157934`)
+	oid, _ := NewOID(`{iso(1) identified-organization(3) dod(6) internet(1)}`)
+
+	set, err := NewCompactSet(`1.3.6.1.4.1.56521.999`, `2.999.1`, *dot, oid, `1.3.6.1`, `{2 999 1}`)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if set.Len() != 4 {
+		t.Fatalf("%s failed: want 4 members, got %d", t.Name(), set.Len())
+	}
+
+	for i, tc := range []struct {
+		x                       any
+		contains, prefix, cover bool
+	}{
+		{`1.3.6.1`, true, true, true},
+		{`1.3.6`, false, true, false},
+		{`1.3.6.1.4.1.56521.999`, true, true, true},
+		{`1.3.6.1.4.1.56521.999.7`, false, false, true},
+		{`1.3.6.1.4.1.56521`, false, true, true},
+		{`2.999`, false, true, false},
+		{`2.999.10`, false, false, false},
+		{`2.25.987895962269883002155146617097157934.1`, false, false, true},
+		{`0.0`, false, false, false},
+		{`bogus`, false, false, false},
+	} {
+		if got := set.Contains(tc.x); got != tc.contains {
+			t.Errorf("%s[%d] failed: Contains want %t, got %t", t.Name(), i, tc.contains, got)
+		}
+		if got := set.HasPrefix(tc.x); got != tc.prefix {
+			t.Errorf("%s[%d] failed: HasPrefix want %t, got %t", t.Name(), i, tc.prefix, got)
+		}
+		if got := set.Covers(tc.x); got != tc.cover {
+			t.Errorf("%s[%d] failed: Covers want %t, got %t", t.Name(), i, tc.cover, got)
+		}
+	}
+
+	for i, want := range []string{
+		`1.3.6.1`,
+		`1.3.6.1.4.1.56521.999`,
+		`2.25.987895962269883002155146617097157934`,
+		`2.999.1`,
+	} {
+		if d, ok := set.DotNotation(i); !ok || d.String() != want {
+			t.Errorf("%s failed: member %d want %s, got %s", t.Name(), i, want, d)
+		}
+	}
+
+	if _, ok := set.DotNotation(set.Len()); ok {
+		t.Errorf("%s failed: out of range member returned", t.Name())
+	}
+
+	if _, err := NewCompactSet(`1`); err == nil {
+		t.Errorf("%s failed: no error for root arc", t.Name())
+	}
+
+	// Members differing from non-members only by a trailing zero
+	// arc must remain distinct.
+	if set, err = NewCompactSet(`1.3.6.1.2.1.1.3.0`, `2.25.0.0`); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+	for x, want := range map[string]bool{
+		`1.3.6.1.2.1.1.3.0`: true,
+		`1.3.6.1.2.1.1.3`:   false,
+		`2.25.0.0`:          true,
+		`2.25.0`:            false,
+		`2.25`:              false,
+	} {
+		if got := set.Contains(x); got != want {
+			t.Errorf("%s failed: Contains(%s) want %t, got %t", t.Name(), x, want, got)
+		}
+	}
+	for i, want := range []string{`1.3.6.1.2.1.1.3.0`, `2.25.0.0`} {
+		if d, ok := set.DotNotation(i); !ok || d.String() != want {
+			t.Errorf("%s failed: member %d want %s, got %s", t.Name(), i, want, d)
+		}
+	}
+
+	var empty *CompactSet
+	if empty.Len() != 0 || empty.Size() != 0 || empty.Contains(`1.3`) {
+		t.Errorf("%s failed: nil instance misbehaved", t.Name())
+	}
+}
@@ -0,0 +1,208 @@
+package objectid
+
+/*
+constraint.go implements allocation constraints enforced by the Registry
+type.
+*/
+
+/*
+Constraint contains an allocation policy attached to an arc within a
+[Registry], governing the registration of records immediately beneath
+that arc. See [Registry.SetConstraint] for details.
+*/
+type Constraint struct {
+	// Min, if non-nil, contains the lowest child arc permitted.
+	Min *NumberForm
+
+	// Max, if non-nil, contains the highest child arc permitted.
+	Max *NumberForm
+
+	// MaxChildren, if non-zero, limits the number of child records.
+	MaxChildren int
+
+	// Frozen indicates that no further child records are permitted.
+	Frozen bool
+}
+
+/*
+validate returns an error if the receiver is self-contradictory.
+*/
+func (r Constraint) validate() (err error) {
+	if r.MaxChildren < 0 {
+		err = errorf("%T bears a negative child limit", r)
+	} else if r.Min != nil && r.Max != nil && r.Min.Gt(*r.Max) {
+		err = errorf("%T bears a minimum (%s) above its maximum (%s)", r, r.Min, r.Max)
+	}
+
+	return
+}
+
+/*
+clone returns a copy of the receiver sharing no storage with it.
+*/
+func (r Constraint) clone() Constraint {
+	for _, nf := range []**NumberForm{&r.Min, &r.Max} {
+		if *nf != nil {
+			c := NumberForm(*(*nf).clone())
+			*nf = &c
+		}
+	}
+
+	return r
+}
+
+/*
+allows returns an error if a record bearing the child arc leaf may not be
+added beneath an arc governed by the receiver, which already bears n
+child records.
+*/
+func (r Constraint) allows(leaf NumberForm, n int) (err error) {
+	switch {
+	case r.Frozen:
+		err = errorf("Arc is frozen")
+	case r.Min != nil && leaf.Lt(*r.Min):
+		err = errorf("Arc %s below permitted minimum %s", leaf, r.Min)
+	case r.Max != nil && leaf.Gt(*r.Max):
+		err = errorf("Arc %s above permitted maximum %s", leaf, r.Max)
+	case r.MaxChildren > 0 && n >= r.MaxChildren:
+		err = errorf("Child limit of %d reached", r.MaxChildren)
+	}
+
+	return
+}
+
+/*
+SetConstraint attaches c to the arc identified by dot, which can be a
+string or [DotNotation], replacing any constraint previously attached.
+The arc need not bear a record. Thereafter, [Registry.Register] and
+[Registry.NewSubordinate] reject any new record immediately beneath the
+arc which would violate c, thereby enforcing organizational allocation
+policies, as do [Registry.Apply], [Registry.ImportTable] and
+[Registry.ImportOIDInfo]. Existing records, and the replacement of
+existing records, are unaffected, as are restorations of previously
+saved content through [Registry.Load].
+
+An error is returned if dot is invalid, or if c bears a negative child
+limit or a minimum above its maximum.
+*/
+func (r *Registry) SetConstraint(dot any, c Constraint) (err error) {
+	key, ok := arcKey(dot)
+	if !ok {
+		err = errorf("Invalid arc for constraint: %v", dot)
+		return
+	} else if err = c.validate(); err != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.constraints == nil {
+		r.constraints = make(map[string]Constraint)
+	}
+	r.constraints[key] = c.clone()
+
+	return
+}
+
+/*
+Constraint returns the [Constraint] attached to the arc identified by
+dot, which can be a string or [DotNotation], alongside a Boolean value
+indicative of a successful lookup.
+*/
+func (r *Registry) Constraint(dot any) (c Constraint, found bool) {
+	if key, ok := arcKey(dot); ok {
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		if c, found = r.constraints[key]; found {
+			c = c.clone()
+		}
+	}
+
+	return
+}
+
+/*
+RemoveConstraint detaches the [Constraint] attached to the arc identified
+by dot, which can be a string or [DotNotation], returning a Boolean value
+indicative of whether a constraint was removed.
+*/
+func (r *Registry) RemoveConstraint(dot any) (removed bool) {
+	if key, ok := arcKey(dot); ok {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if _, removed = r.constraints[key]; removed {
+			delete(r.constraints, key)
+		}
+	}
+
+	return
+}
+
+/*
+CheckChild returns an error if a new record bearing dot, which can be a
+string or [DotNotation], would violate the [Constraint] attached to its
+parent arc. No error is returned if dot is already registered, or if its
+parent bears no constraint.
+*/
+func (r *Registry) CheckChild(dot any) (err error) {
+	d, ok := registryDot(dot)
+	if !ok {
+		err = errorf("Invalid arc for constraint check: %v", dot)
+		return
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.checkChild(d)
+}
+
+/*
+checkChild returns an error if a new record bearing dot would violate the
+constraint of its parent. The caller must hold a lock.
+*/
+func (r *Registry) checkChild(dot DotNotation) (err error) {
+	if len(r.constraints) == 0 || dot.Len() < 2 || r.root.find(dot) != nil {
+		return
+	}
+
+	parent := dot[:dot.Len()-1]
+	if c, found := r.constraints[parent.String()]; found {
+		if err = c.allows(dot.Leaf(), r.root.children(parent)); err != nil {
+			err = errorf("Registration of %s beneath %s denied: %v", dot, parent, err)
+		}
+	}
+
+	return
+}
+
+/*
+NewSubordinate returns a new instance of [DotNotation] bearing arc, which
+may be any type accepted by [DotNotation.NewSubordinate], beneath parent,
+which can be a string or [DotNotation], alongside an error. An error is
+returned if the result would violate the [Constraint] attached to parent;
+see [Registry.CheckChild]. The result is not registered.
+*/
+func (r *Registry) NewSubordinate(parent any, arc any) (dot DotNotation, err error) {
+	p, ok := registryDot(parent)
+	if !ok {
+		err = errorf("Invalid parent arc: %v", parent)
+		return
+	}
+
+	D := p.NewSubordinate(arc)
+	if D == nil {
+		err = errorf("Invalid subordinate arc: %v", arc)
+		return
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if err = r.checkChild(*D); err == nil {
+		dot = *D
+	}
+
+	return
+}
@@ -0,0 +1,137 @@
+package objectid
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func ExampleRegistry_SetConstraint() {
+	reg := NewRegistry()
+	max, _ := NewNumberForm(99)
+	if err := reg.SetConstraint(`1.3.6.1.4.1.56521`, Constraint{Max: &max}); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, dot := range []string{`1.3.6.1.4.1.56521.1`, `1.3.6.1.4.1.56521.100`} {
+		err := reg.Register(Record{Dot: mustDot(dot)})
+		fmt.Println(dot, err)
+	}
+	// Output:
+	// 1.3.6.1.4.1.56521.1 <nil>
+	// 1.3.6.1.4.1.56521.100 Registration of 1.3.6.1.4.1.56521.100 beneath 1.3.6.1.4.1.56521 denied: Arc 100 above permitted maximum 99
+}
+
+func TestRegistry_constraints(t *testing.T) {
+	reg := NewRegistry()
+	min, _ := NewNumberForm(10)
+	max, _ := NewNumberForm(20)
+	if err := reg.SetConstraint(`2.999`, Constraint{Min: &min, Max: &max, MaxChildren: 2}); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	// A deeper record does not count as a child.
+	for _, dot := range []string{`2.999.10.5`, `2.999.10`, `2.999.11`} {
+		if err := reg.Register(Record{Dot: mustDot(dot)}); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		}
+	}
+
+	for _, dot := range []string{`2.999.9`, `2.999.21`, `2.999.12`} {
+		if err := reg.Register(Record{Dot: mustDot(dot)}); err == nil {
+			t.Errorf("%s failed: %s registered despite constraint", t.Name(), dot)
+		}
+	}
+
+	// Replacement of an existing record is permitted.
+	if err := reg.Register(Record{Dot: mustDot(`2.999.11`), Identifier: `replaced`}); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	}
+
+	if _, err := reg.NewSubordinate(`2.999`, 15); err == nil {
+		t.Errorf("%s failed: expected child limit error, got nothing", t.Name())
+	} else if reg.Unregister(`2.999.11`); reg.CheckChild(`2.999.15`) != nil {
+		t.Errorf("%s failed: child limit not relaxed following removal", t.Name())
+	} else if dot, err := reg.NewSubordinate(`2.999`, 15); err != nil || dot.String() != `2.999.15` {
+		t.Errorf("%s failed: want 2.999.15, got %s (%v)", t.Name(), dot, err)
+	}
+
+	c, found := reg.Constraint(`2.999`)
+	if !found || c.MaxChildren != 2 || !c.Min.Equal(10) {
+		t.Errorf("%s failed: unexpected constraint %+v", t.Name(), c)
+	}
+	c.Frozen = true
+	if err := reg.SetConstraint(`2.999`, c); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if err = reg.CheckChild(`2.999.15`); err == nil {
+		t.Errorf("%s failed: frozen arc accepted a child", t.Name())
+	}
+
+	if !reg.RemoveConstraint(`2.999`) || reg.RemoveConstraint(`2.999`) {
+		t.Errorf("%s failed: unexpected constraint removal result", t.Name())
+	} else if err := reg.Register(Record{Dot: mustDot(`2.999.9`)}); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	}
+
+	for _, bogus := range []Constraint{
+		{MaxChildren: -1},
+		{Min: &max, Max: &min},
+	} {
+		if err := reg.SetConstraint(`2.999`, bogus); err == nil {
+			t.Errorf("%s failed: bogus constraint %+v accepted", t.Name(), bogus)
+		}
+	}
+	if err := reg.SetConstraint(`bogus`, Constraint{}); err == nil {
+		t.Errorf("%s failed: bogus arc accepted", t.Name())
+	} else if _, err = reg.NewSubordinate(`2.999`, -1); err == nil {
+		t.Errorf("%s failed: bogus subordinate accepted", t.Name())
+	}
+}
+
+func TestRegistry_constraints_import(t *testing.T) {
+	table := "2.999.1,one\n2.999.2,two\n2.999.3,three\n"
+	doc := `<oid-database>
+	<oid><dot-notation>2.999.1</dot-notation></oid>
+	<oid><dot-notation>2.999.2</dot-notation></oid>
+</oid-database>`
+
+	for idx, tc := range []struct {
+		imp  func(*Registry) error
+		want int
+	}{
+		{func(reg *Registry) error { return reg.ImportTable(strings.NewReader(table)) }, 0},
+		{func(reg *Registry) error { return reg.ImportTable(strings.NewReader(table), WithStreaming()) }, 1},
+		{func(reg *Registry) error { return reg.ImportOIDInfo(strings.NewReader(doc)) }, 0},
+	} {
+		reg := NewRegistry()
+		if err := reg.SetConstraint(`2.999`, Constraint{MaxChildren: 1}); err != nil {
+			t.Fatalf("%s failed: %v", t.Name(), err)
+		}
+
+		if err := tc.imp(reg); err == nil {
+			t.Errorf("%s[%d] failed: constraint bypassed by import", t.Name(), idx)
+		} else if reg.Len() != tc.want {
+			t.Errorf("%s[%d] failed: want %d records, got %d", t.Name(), idx, tc.want, reg.Len())
+		}
+	}
+}
+
+func TestRegNode_children(t *testing.T) {
+	reg := NewRegistry()
+	for _, dot := range []string{`1.3.6.1.4.1.56521.1`, `1.3.6.1.4.1.56521.2.1`} {
+		_ = reg.Register(Record{Dot: mustDot(dot)})
+	}
+
+	for prefix, want := range map[string]int{
+		`1.3.6.1.4.1`:         0,
+		`1.3.6.1.4.1.56521`:   1,
+		`1.3.6.1.4.1.56521.2`: 1,
+		`1.3.6.1.4`:           0,
+		`2.999`:               0,
+	} {
+		if got := reg.root.children(mustDot(prefix)); got != want {
+			t.Errorf("%s failed: %s want %d, got %d", t.Name(), prefix, want, got)
+		}
+	}
+}
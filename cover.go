@@ -0,0 +1,80 @@
+package objectid
+
+/*
+cover.go implements the computation of covering prefixes for sets of OIDs.
+*/
+
+import "sort"
+
+/*
+CoveringPrefixes returns the smallest set of subtree prefixes which
+together cover each of the input oids, ordered such that each prefix
+sorts before the next, alongside an error. Each input may be a string,
+[DotNotation], [ASN1Notation] or [OID] (or a pointer thereto). This is
+useful when generating compact ACLs and certificate policy constraints.
+
+A prefix covers itself and all of its descendants. When maxPrefixes is
+zero (0), no over-coverage is tolerated: the result contains exactly those
+inputs which are not descendants of other inputs. Otherwise, prefixes are
+repeatedly merged into their deepest common ancestor, thereby covering the
+fewest additional OIDs possible at each step, until no more than
+maxPrefixes remain. Merging never produces a prefix of fewer than two (2)
+arcs.
+
+An error is returned if any input is invalid, or if the result cannot be
+reduced to maxPrefixes, in which case the most reduced result is returned
+alongside the error.
+*/
+func CoveringPrefixes(maxPrefixes int, oids ...any) (prefixes []DotNotation, err error) {
+	if maxPrefixes < 0 {
+		err = errorf("Maximum prefix count cannot be negative")
+		return
+	}
+
+	dots := make([]DotNotation, 0, len(oids))
+	for i := 0; i < len(oids); i++ {
+		d := numericArcs(oids[i])
+		if !d.Valid() {
+			err = errorf("Invalid OID at index %d: %v", i, oids[i])
+			return
+		}
+		dots = append(dots, d)
+	}
+
+	sort.Slice(dots, func(i, j int) bool { return dots[i].compare(dots[j]) < 0 })
+
+	// Ancestors sort before their descendants, so any
+	// input covered by another follows its coverer.
+	for i := 0; i < len(dots); i++ {
+		if n := len(prefixes); n > 0 && dots[i].hasDotPrefix(prefixes[n-1]) {
+			continue
+		}
+		prefixes = append(prefixes, dots[i].clone())
+	}
+
+	for maxPrefixes > 0 && len(prefixes) > maxPrefixes {
+		// The deepest common ancestor of any two members
+		// is that of some adjacent pair.
+		at, depth := -1, 1
+		for i := 0; i+1 < len(prefixes); i++ {
+			if d := sharedArcs(prefixes[i], prefixes[i+1]); d > depth {
+				at, depth = i, d
+			}
+		}
+
+		if at < 0 {
+			err = errorf("Cannot cover %d OIDs within %d prefixes", len(oids), maxPrefixes)
+			return
+		}
+
+		// Members bearing the ancestor are contiguous.
+		anc := prefixes[at][:depth:depth]
+		end := at + 1
+		for end < len(prefixes) && sharedArcs(prefixes[end], anc) == depth {
+			end++
+		}
+		prefixes = append(append(prefixes[:at], anc), prefixes[end:]...)
+	}
+
+	return
+}
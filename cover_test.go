@@ -0,0 +1,68 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleCoveringPrefixes() {
+	oids := []any{
+		`1.3.6.1.4.1.56521.1.1`,
+		`1.3.6.1.4.1.56521.1.2`,
+		`1.3.6.1.4.1.56521.2`,
+		`1.3.6.1.4.1.56521.1`,
+		`2.5.4.3`,
+	}
+
+	exact, _ := CoveringPrefixes(0, oids...)
+	fmt.Println(exact)
+
+	merged, _ := CoveringPrefixes(2, oids...)
+	fmt.Println(merged)
+	// Output:
+	// [1.3.6.1.4.1.56521.1 1.3.6.1.4.1.56521.2 2.5.4.3]
+	// [1.3.6.1.4.1.56521 2.5.4.3]
+}
+
+func TestCoveringPrefixes(t *testing.T) {
+	for idx, tc := range []struct {
+		max  int
+		in   []any
+		want string
+	}{
+		{0, nil, `[]`},
+		{0, []any{`2.999`, `2.999`, `2.999.1`}, `[2.999]`},
+		{1, []any{`2.999.1.1`, `2.999.1.2`, `2.999.2`}, `[2.999]`},
+		{2, []any{`2.999.1.1`, `2.999.1.2`, `2.999.2`}, `[2.999.1 2.999.2]`},
+		{2, []any{`1.3.6.1.2.1`, `1.3.6.1.4.1.9`, `1.3.6.1.4.1.56521`, `2.5.4.3`}, `[1.3.6.1 2.5.4.3]`},
+		{3, []any{mustDot(`1.3.6.1.2.1`), `{iso(1) 3 6 1 4 1 9}`, `1.3.6.1.4.1.56521`, `2.5.4.3`}, `[1.3.6.1.2.1 1.3.6.1.4.1 2.5.4.3]`},
+	} {
+		got, err := CoveringPrefixes(tc.max, tc.in...)
+		if err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+		} else if s := fmt.Sprint(got); s != tc.want {
+			t.Errorf("%s[%d] failed: want %s, got %s", t.Name(), idx, tc.want, s)
+		}
+	}
+
+	// Inputs are not aliased.
+	in := mustDot(`2.999.1`)
+	got, _ := CoveringPrefixes(0, in)
+	_ = got[0].SetIndex(2, 5)
+	if in.String() != `2.999.1` {
+		t.Errorf("%s failed: input modified through result", t.Name())
+	}
+
+	// Disjoint root arcs cannot be merged.
+	if got, err := CoveringPrefixes(1, `1.3.6`, `2.999`); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	} else if len(got) != 2 {
+		t.Errorf("%s failed: want partial result, got %v", t.Name(), got)
+	}
+
+	if _, err := CoveringPrefixes(0, `bogus`); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	} else if _, err = CoveringPrefixes(-1); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+}
@@ -0,0 +1,388 @@
+package objectid
+
+/*
+dict.go contains the name dictionary, which associates well-known
+arcs with their ASN.1 identifiers.
+*/
+
+import "sync"
+
+/*
+nameDictionary contains the known ASN.1 identifiers for individual arcs,
+keyed by the dot notation of the arc (e.g.: "1.3.6.1"). The identifiers
+within byArc are preferred, and are used when rendering values, while the
+synonyms map contains alternate (e.g.: historical) identifiers which are
+only honored when parsing.
+
+The children map indexes the keys of byArc by the dot notation of their
+parent, allowing quick resolution of identifiers during ASN1Notation
+parsing.
+*/
+var nameDictionary = struct {
+	sync.RWMutex
+	byArc    map[string]string
+	synonyms map[string][]string
+	children map[string][]string
+}{
+	byArc: map[string]string{
+		`0`:                `itu-t`,
+		`0.0`:              `recommendation`,
+		`0.1`:              `question`,
+		`0.2`:              `administration`,
+		`0.3`:              `network-operator`,
+		`0.4`:              `identified-organization`,
+		`1`:                `iso`,
+		`1.0`:              `standard`,
+		`1.1`:              `registration-authority`,
+		`1.2`:              `member-body`,
+		`1.2.840`:          `us`,
+		`1.2.840.113549`:   `rsadsi`,
+		`1.2.840.113549.1`: `pkcs`,
+		`1.2.840.10040`:    `x9-57`,
+		`1.2.840.10045`:    `ansi-X9-62`,
+		`1.3`:              `identified-organization`,
+		`1.3.6`:            `dod`,
+		`1.3.6.1`:          `internet`,
+		`1.3.6.1.1`:        `directory`,
+		`1.3.6.1.2`:        `mgmt`,
+		`1.3.6.1.2.1`:      `mib-2`,
+		`1.3.6.1.3`:        `experimental`,
+		`1.3.6.1.4`:        `private`,
+		`1.3.6.1.4.1`:      `enterprise`,
+		`1.3.6.1.5`:        `security`,
+		`1.3.6.1.5.5`:      `mechanisms`,
+		`1.3.6.1.5.5.7`:    `pkix`,
+		`1.3.6.1.6`:        `snmpV2`,
+		`2`:                `joint-iso-itu-t`,
+		`2.1`:              `asn1`,
+		`2.5`:              `ds`,
+		`2.5.4`:            `attributeType`,
+		`2.5.6`:            `objectClass`,
+		`2.5.29`:           `certificateExtension`,
+		`2.16`:             `country`,
+		`2.16.840`:         `us`,
+		`2.16.840.1`:       `organization`,
+		`2.17`:             `registration-procedures`,
+		`2.23`:             `international-organizations`,
+		`2.25`:             `uuid`,
+		`2.27`:             `tag-based`,
+		`2.999`:            `example`,
+	},
+	synonyms: map[string][]string{
+		`0`:           {`ccitt`, `itu-r`},
+		`1.3`:         {`org`},
+		`1.3.6.1.4.1`: {`enterprises`},
+		`2`:           {`joint-iso-ccitt`},
+	},
+	children: make(map[string][]string),
+}
+
+func init() {
+	for key := range nameDictionary.byArc {
+		parent := parentOfKey(key)
+		nameDictionary.children[parent] = append(nameDictionary.children[parent], key)
+	}
+}
+
+/*
+RegisterIdentifier assigns the ASN.1 identifier id to the arc identified
+by dot, which can be a string (e.g.: "1.3.6.1.4.1.56521") or [DotNotation],
+within the package-wide name dictionary. The identifier is preferred, and
+any identifier previously assigned to the arc is replaced. However, should
+id already be a synonym of the arc, it is promoted and the identifier it
+replaces becomes a synonym in its stead. See [RegisterSynonyms] for details.
+
+An error is returned if dot is invalid or if id does not satisfy
+[IsIdentifier].
+*/
+func RegisterIdentifier(dot any, id string) (err error) {
+	key, ok := arcKey(dot)
+	if !ok {
+		err = errorf("Invalid arc for identifier registration: %v", dot)
+		return
+	} else if !isIdentifier(id) {
+		err = errorf("Invalid identifier '%s'", id)
+		return
+	}
+
+	nameDictionary.Lock()
+	defer nameDictionary.Unlock()
+
+	syn := nameDictionary.synonyms[key]
+	for i := 0; i < len(syn); i++ {
+		if syn[i] == id {
+			// Promote the synonym, demoting the
+			// former preferred identifier.
+			syn = append(syn[:i:i], syn[i+1:]...)
+			if old, found := nameDictionary.byArc[key]; found {
+				syn = append(syn, old)
+			}
+			nameDictionary.synonyms[key] = syn
+			break
+		}
+	}
+
+	if _, found := nameDictionary.byArc[key]; !found {
+		parent := parentOfKey(key)
+		nameDictionary.children[parent] = append(nameDictionary.children[parent], key)
+	}
+	nameDictionary.byArc[key] = id
+
+	return
+}
+
+/*
+RegisterSynonyms assigns one (1) or more alternate ASN.1 identifiers to
+the arc identified by dot, which can be a string or [DotNotation], within
+the package-wide name dictionary. Synonyms, such as "org" in lieu of
+"identified-organization" for 1.3, are accepted when resolving names, but
+the preferred identifier assigned by [RegisterIdentifier] is always used
+when rendering values.
+
+An error is returned if dot is invalid, if the arc bears no preferred
+identifier, or if any id does not satisfy [IsIdentifier]. Synonyms equal
+to the preferred identifier, or already assigned, are ignored.
+*/
+func RegisterSynonyms(dot any, ids ...string) (err error) {
+	key, ok := arcKey(dot)
+	if !ok {
+		err = errorf("Invalid arc for synonym registration: %v", dot)
+		return
+	} else if len(ids) == 0 {
+		err = errorf("No synonyms provided for %s", key)
+		return
+	}
+
+	for i := 0; i < len(ids); i++ {
+		if !isIdentifier(ids[i]) {
+			err = errorf("Invalid identifier '%s'", ids[i])
+			return
+		}
+	}
+
+	nameDictionary.Lock()
+	defer nameDictionary.Unlock()
+
+	pref, found := nameDictionary.byArc[key]
+	if !found {
+		err = errorf("No preferred identifier registered for %s", key)
+		return
+	}
+
+	for i := 0; i < len(ids); i++ {
+		if ids[i] != pref && !strInSlice(ids[i], nameDictionary.synonyms[key]) {
+			nameDictionary.synonyms[key] = append(nameDictionary.synonyms[key], ids[i])
+		}
+	}
+
+	return
+}
+
+/*
+LookupSynonyms returns the alternate ASN.1 identifiers assigned to the arc
+identified by dot, which can be a string or [DotNotation]. The preferred
+identifier, available through [LookupIdentifier], is not included.
+*/
+func LookupSynonyms(dot any) (ids []string) {
+	if key, ok := arcKey(dot); ok {
+		nameDictionary.RLock()
+		defer nameDictionary.RUnlock()
+
+		if syn := nameDictionary.synonyms[key]; len(syn) > 0 {
+			ids = make([]string, len(syn))
+			copy(ids, syn)
+		}
+	}
+
+	return
+}
+
+/*
+LookupIdentifier returns the ASN.1 identifier assigned to the arc identified
+by dot, which can be a string or [DotNotation], alongside a Boolean value
+indicative of a successful lookup.
+*/
+func LookupIdentifier(dot any) (id string, found bool) {
+	if key, ok := arcKey(dot); ok {
+		id, found = lookupIdentifier(key)
+	}
+
+	return
+}
+
+func lookupIdentifier(key string) (id string, found bool) {
+	nameDictionary.RLock()
+	defer nameDictionary.RUnlock()
+
+	id, found = nameDictionary.byArc[key]
+	return
+}
+
+/*
+arcKey returns the dot notation key of the arc identified by dot, which
+can be a string or [DotNotation], alongside a Boolean value indicative of
+success. Unlike [NewDotNotation], a root arc alone (e.g.: "1") is accepted.
+*/
+func arcKey(dot any) (key string, ok bool) {
+	switch tv := dot.(type) {
+	case string:
+		var d DotNotation
+		if d, ok = parseArcKey(tv); ok {
+			key = d.String()
+		}
+	case *DotNotation:
+		if ok = tv != nil && tv.Len() > 0 && tv.Root().Lt(3); ok {
+			key = tv.String()
+		}
+	case DotNotation:
+		if ok = tv.Len() > 0 && tv.Root().Lt(3); ok {
+			key = tv.String()
+		}
+	}
+
+	return
+}
+
+/*
+parseArcKey returns a [DotNotation] parsed from key, which may represent
+a root arc alone, alongside a Boolean value indicative of success.
+*/
+func parseArcKey(key string) (d DotNotation, ok bool) {
+	var err error
+	if len(key) == 1 && '0' <= key[0] && key[0] <= '2' {
+		d, err = dotFromKey(key)
+	} else {
+		d, err = parseDotString(key, newParseConfig())
+	}
+
+	if ok = err == nil; !ok {
+		d = nil
+	}
+
+	return
+}
+
+/*
+ASN returns an instance of [ASN1Notation] based upon the receiver. Each
+[NameAndNumberForm] bears the identifier found within the package-wide
+name dictionary, if any. See [RegisterIdentifier] for details.
+*/
+func (r DotNotation) ASN() (a ASN1Notation) {
+	if r.Len() > 0 {
+		a = make(ASN1Notation, r.Len())
+		for i := 0; i < r.Len(); i++ {
+			a[i] = NameAndNumberForm{
+				primaryIdentifier: r[i],
+				parsed:            true,
+			}
+			a[i].identifier, _ = lookupIdentifier(r[:i+1].String())
+		}
+	}
+
+	return
+}
+
+/*
+NewASN1NotationFromPath returns an instance of *[ASN1Notation] parsed from
+the slash-separated name path, alongside an error. For example:
+
+	/iso/identified-organization/dod/internet/private/enterprise/56521
+
+Each path component may be an ASN.1 identifier or synonym thereof, which
+is resolved beneath the preceding arc using the package-wide name dictionary
+and replaced with the preferred identifier of the arc, a non-negative
+number, which is passed through as-is, or a nameAndNumber form such as
+"example(999)". Surrounding whitespace and a trailing solidus are tolerated.
+
+Unlike [NewIRINotation], this is a lenient parser intended for human input.
+The resulting value may be converted using the [ASN1Notation.Dot] method.
+*/
+func NewASN1NotationFromPath(path string) (r *ASN1Notation, err error) {
+	path = trimR(trimS(path), `/`)
+	if len(path) < 2 || path[0] != '/' {
+		err = errorf("Name path must begin with a solidus ('/') and contain at least one component")
+		return
+	}
+
+	comps := split(path[1:], `/`)
+	t := make(ASN1Notation, len(comps))
+	var parent string
+	for i := 0; i < len(comps); i++ {
+		var nanf *NameAndNumberForm
+		switch comp := trimS(comps[i]); {
+		case isNumber(comp), contains(comp, `(`):
+			nanf, err = NewNameAndNumberForm(comp)
+		case isIdentifier(comp):
+			key, pref, found := resolveIdentifier(parent, comp)
+			if !found {
+				err = errorf("Unresolvable identifier '%s' beneath '%s'", comp, parent)
+				return
+			}
+			nanf, err = NewNameAndNumberForm(pref + `(` + key[lastIndex(key, `.`)+1:] + `)`)
+		default:
+			err = errorf("Invalid name path component '%s'", comp)
+		}
+
+		if err != nil {
+			return
+		}
+
+		t[i] = *nanf
+		if i == 0 {
+			parent = nanf.NumberForm().String()
+		} else {
+			parent += `.` + nanf.NumberForm().String()
+		}
+	}
+
+	if err = t.Validate(); err == nil {
+		r = &t
+	}
+
+	return
+}
+
+/*
+resolveIdentifier returns the dot notation key and preferred identifier of
+the arc bearing the ASN.1 identifier or synonym id beneath the arc identified
+by parent. A zero parent indicates a root arc is sought. Preferred
+identifiers take precedence over synonyms.
+*/
+func resolveIdentifier(parent, id string) (key, pref string, found bool) {
+	nameDictionary.RLock()
+	defer nameDictionary.RUnlock()
+
+	children := nameDictionary.children[parent]
+	for i := 0; i < len(children); i++ {
+		k := children[i]
+		if v := nameDictionary.byArc[k]; v == id {
+			return k, v, true
+		} else if !found && strInSlice(id, nameDictionary.synonyms[k]) {
+			key, pref, found = k, v, true
+		}
+	}
+
+	return
+}
+
+/*
+named returns a copy of the receiver in which each unnamed arc bears its
+identifier within the package-wide name dictionary, if any.
+*/
+func (r ASN1Notation) named() (a ASN1Notation) {
+	a = make(ASN1Notation, len(r))
+	copy(a, r)
+
+	key := make([]byte, 0, 8*len(r))
+	for i := 0; i < len(a); i++ {
+		if i > 0 {
+			key = append(key, '.')
+		}
+		key = a[i].primaryIdentifier.AppendDecimal(key)
+		if len(a[i].identifier) == 0 {
+			a[i].identifier, _ = lookupIdentifier(string(key))
+		}
+	}
+
+	return
+}
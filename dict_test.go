@@ -0,0 +1,151 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleRegisterIdentifier() {
+	if err := RegisterIdentifier(`1.3.6.1.4.1.32473`, `documentation`); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.32473.999`)
+	fmt.Println(dot.ASN())
+	// Output: {iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) documentation(32473) 999}
+}
+
+func TestLookupIdentifier(t *testing.T) {
+	for idx, dot := range []any{
+		`1`,
+		`2.999`,
+		`1.3.6.1.4.1`,
+		DotNotation{ISO.primaryIdentifier},
+	} {
+		want := []string{`iso`, `example`, `enterprise`, `iso`}[idx]
+		if got, found := LookupIdentifier(dot); !found || got != want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		}
+	}
+
+	for _, bogus := range []any{``, `3`, `1.3.6.1.4.1.99999999`, `x.1`, 7} {
+		if _, found := LookupIdentifier(bogus); found {
+			t.Errorf("%s failed: bogus lookup %v succeeded", t.Name(), bogus)
+		}
+	}
+
+	if err := RegisterIdentifier(`1.3.6.1.4.1.999999`, `Bogus`); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	} else if err = RegisterIdentifier(`3.1`, `bogus`); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+}
+
+func ExampleNewASN1NotationFromPath() {
+	a, err := NewASN1NotationFromPath(`/iso/identified-organization/dod/internet/private/enterprise/56521`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s\n%s\n", a, a.Dot())
+	// Output:
+	// {iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) 56521}
+	// 1.3.6.1.4.1.56521
+}
+
+func TestNewASN1NotationFromPath(t *testing.T) {
+	for path, want := range map[string]string{
+		`/joint-iso-itu-t/example`:           `2.999`,
+		` /joint-iso-itu-t/example(999)/1/ `: `2.999.1`,
+		`/1/3/dod`:                           `1.3.6`,
+		`/iso/member-body/us/rsadsi/pkcs`:    `1.2.840.113549.1`,
+		`/joint-iso-itu-t/country/us`:        `2.16.840`,
+	} {
+		a, err := NewASN1NotationFromPath(path)
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if got := a.Dot().String(); got != want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		}
+	}
+
+	for _, bogus := range []string{
+		``,
+		`iso/identified-organization`,
+		`/`,
+		`/iso/nonexistent`,
+		`/iso//dod`,
+		`/3/1`,
+		`/iso/dod`,
+		`/iso/bogus_name`,
+	} {
+		if _, err := NewASN1NotationFromPath(bogus); err == nil {
+			t.Errorf("%s failed: bogus path '%s' parsed without error", t.Name(), bogus)
+		}
+	}
+}
+
+func ExampleRegisterSynonyms() {
+	a, err := NewASN1NotationFromPath(`/iso/org/dod/internet/private/enterprises`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(a)
+	// Output: {iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1)}
+}
+
+func TestRegisterSynonyms(t *testing.T) {
+	if syn := LookupSynonyms(`1.3`); len(syn) != 1 || syn[0] != `org` {
+		t.Errorf("%s failed: unexpected synonyms %v", t.Name(), syn)
+	}
+
+	const key = `2.999.2468`
+	if err := RegisterSynonyms(key, `alt`); err == nil {
+		t.Errorf("%s failed: expected error for arc without identifier", t.Name())
+	}
+
+	if err := RegisterIdentifier(key, `preferred`); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if err = RegisterSynonyms(key, `historical`, `preferred`, `historical`); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if err = RegisterSynonyms(key, `Bogus`); err == nil {
+		t.Errorf("%s failed: expected error for invalid synonym", t.Name())
+	}
+
+	if syn := LookupSynonyms(key); len(syn) != 1 || syn[0] != `historical` {
+		t.Errorf("%s failed: unexpected synonyms %v", t.Name(), syn)
+	}
+
+	a, err := NewASN1NotationFromPath(`/joint-iso-ccitt/example/historical`)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if got := a.String(); got != `{joint-iso-itu-t(2) example(999) preferred(2468)}` {
+		t.Errorf("%s failed: got '%s'", t.Name(), got)
+	}
+
+	// Promotion of a synonym demotes the former preferred identifier.
+	if err = RegisterIdentifier(key, `historical`); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if id, _ := LookupIdentifier(key); id != `historical` {
+		t.Errorf("%s failed: want 'historical', got '%s'", t.Name(), id)
+	} else if syn := LookupSynonyms(key); len(syn) != 1 || syn[0] != `preferred` {
+		t.Errorf("%s failed: unexpected synonyms %v", t.Name(), syn)
+	}
+
+	// Re-registration must not duplicate the arc beneath its parent.
+	var n int
+	for _, k := range nameDictionary.children[`2.999`] {
+		if k == key {
+			n++
+		}
+	}
+	if n != 1 {
+		t.Errorf("%s failed: want %s indexed once beneath 2.999, got %d", t.Name(), key, n)
+	} else if k, pref, found := resolveIdentifier(`2.999`, `preferred`); !found || k != key || pref != `historical` {
+		t.Errorf("%s failed: unexpected resolution of demoted identifier: %s, %s, %t", t.Name(), k, pref, found)
+	}
+}
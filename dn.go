@@ -0,0 +1,155 @@
+package objectid
+
+/*
+dn.go implements conversion between DotNotation and the registration
+distinguished names described by the OID Directory Internet-Drafts.
+*/
+
+/*
+DefaultRegistrationBase is the default LDAP distinguished name beneath
+which registration entries reside, per draft-coretta-oiddir-schema.
+*/
+const DefaultRegistrationBase = `ou=Registrations,o=rA`
+
+/*
+DN returns the LDAP distinguished name of the receiver in the form used
+by the OID Directory, in which each arc is represented by an "n" RDN with
+the leaf arc first, followed by base. If base is zero, the value of
+[DefaultRegistrationBase] is used.
+
+For example, "1.3.6.1.4.1.56521" yields:
+
+	n=56521,n=1,n=4,n=1,n=6,n=3,n=1,ou=Registrations,o=rA
+
+A zero string is returned if the receiver is zero.
+*/
+func (r DotNotation) DN(base string) (dn string) {
+	if r.Len() == 0 {
+		return
+	}
+
+	if len(base) == 0 {
+		base = DefaultRegistrationBase
+	}
+
+	rdns := make([]string, 0, r.Len()+1)
+	for i := r.Len() - 1; i >= 0; i-- {
+		rdns = append(rdns, `n=`+r[i].String())
+	}
+	rdns = append(rdns, base)
+	dn = join(rdns, `,`)
+
+	return
+}
+
+/*
+NewDotNotationFromDN returns an instance of *[DotNotation] parsed from the
+LDAP distinguished name dn, alongside an error. This is the inverse of the
+[DotNotation.DN] method.
+
+The leading RDNs of dn must each be of the form "n=<arc>", ordered from
+leaf to root. Any RDNs which follow (e.g.: "ou=Registrations,o=rA") are
+considered base components and are ignored, however no "n" RDN may appear
+amongst them. Attribute types are matched without regard for case. Arcs
+must be unsigned decimal values without leading zeros.
+
+Alternatively, a DN whose first RDN is of the form "dotNotation=<oid>" is
+also supported.
+
+Note that a DN describing a root arc alone (e.g.: "n=1,ou=Registrations,o=rA")
+results in a single-arc [DotNotation], which does not satisfy the
+[DotNotation.Valid] method.
+*/
+func NewDotNotationFromDN(dn string) (r *DotNotation, err error) {
+	rdns := splitDN(dn)
+	if len(rdns) == 0 {
+		err = errorf("Zero length DN")
+		return
+	}
+
+	var arcs []string
+	for i := 0; i < len(rdns); i++ {
+		typ, val, ok := cutRDN(rdns[i])
+		if !ok {
+			err = errorf("Invalid RDN '%s'", rdns[i])
+			return
+		}
+
+		switch {
+		case i == 0 && eq(typ, `dotNotation`):
+			r, err = NewDotNotation(val, RejectLeadingZeros())
+			return
+		case eq(typ, `n`):
+			if len(arcs) < i {
+				err = errorf("RDN '%s' follows base components", rdns[i])
+				return
+			}
+			arcs = append(arcs, val)
+		}
+	}
+
+	if len(arcs) == 0 {
+		err = errorf("No 'n' RDNs found in DN '%s'", dn)
+		return
+	}
+
+	_d := make(DotNotation, len(arcs))
+	for i := 0; i < len(arcs); i++ {
+		// RDNs are ordered leaf first, so reverse them.
+		if _d[len(arcs)-1-i], err = NewNumberForm(arcs[i], RejectLeadingZeros()); err != nil {
+			return
+		}
+	}
+
+	if !_d.Root().Lt(3) {
+		err = errorf("Invalid root arc %s in DN", _d.Root())
+		return
+	} else if _d.Len() >= 2 {
+		if err = _d.Validate(); err != nil {
+			return
+		}
+	}
+
+	r = &_d
+
+	return
+}
+
+/*
+splitDN returns the RDNs of dn, splitting upon unescaped commas. Leading
+and trailing whitespace surrounding each RDN is removed.
+*/
+func splitDN(dn string) (rdns []string) {
+	var last int
+	for i := 0; i < len(dn); i++ {
+		switch dn[i] {
+		case '\\':
+			i++
+		case ',':
+			rdns = append(rdns, trimS(dn[last:i]))
+			last = i + 1
+		}
+	}
+
+	if rest := trimS(dn[last:]); len(rest) > 0 || len(rdns) > 0 {
+		rdns = append(rdns, rest)
+	}
+
+	return
+}
+
+/*
+cutRDN returns the attribute type and value of the single-valued rdn,
+alongside a Boolean value indicative of success.
+*/
+func cutRDN(rdn string) (typ, val string, ok bool) {
+	idx := indexRune(rdn, '=')
+	if idx <= 0 || contains(rdn, `+`) {
+		return
+	}
+
+	typ, val = trimS(rdn[:idx]), trimS(rdn[idx+1:])
+	ok = len(typ) > 0 && len(val) > 0
+
+	return
+}
@@ -0,0 +1,76 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleDotNotation_DN() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	fmt.Println(dot.DN(``))
+	// Output: n=56521,n=1,n=4,n=1,n=6,n=3,n=1,ou=Registrations,o=rA
+}
+
+func TestDotNotation_DN(t *testing.T) {
+	dot, _ := NewDotNotation(`2.999`)
+	if got := dot.DN(`ou=OIDs,dc=example,dc=com`); got != `n=999,n=2,ou=OIDs,dc=example,dc=com` {
+		t.Errorf("%s failed: unexpected DN '%s'", t.Name(), got)
+	}
+
+	var zero DotNotation
+	if got := zero.DN(``); got != `` {
+		t.Errorf("%s failed: unexpected DN '%s'", t.Name(), got)
+	}
+}
+
+func ExampleNewDotNotationFromDN() {
+	dot, err := NewDotNotationFromDN(`n=56521,n=1,n=4,n=1,n=6,n=3,n=1,ou=Registrations,o=rA`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(dot)
+	// Output: 1.3.6.1.4.1.56521
+}
+
+func TestNewDotNotationFromDN(t *testing.T) {
+	for dn, want := range map[string]string{
+		`n=999,n=2,ou=Registrations,o=rA`:           `2.999`,
+		`N=999, n=2`:                                `2.999`,
+		`n = 3 ,n=1,dc=example,dc=com`:              `1.3`,
+		`dotNotation=1.3.6.1,ou=Registrations,o=rA`: `1.3.6.1`,
+		`n=1,ou=Registrations,o=rA`:                 `1`,
+		`n=6,n=3,n=1,ou=Registrations\, Inc.,o=rA`:  `1.3.6`,
+	} {
+		dot, err := NewDotNotationFromDN(dn)
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if dot.String() != want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, dot)
+		}
+	}
+
+	for _, bogus := range []string{
+		``,
+		`ou=Registrations,o=rA`,
+		`n=1,ou=Registrations,n=3`,
+		`n=03,n=1`,
+		`n=x,n=1`,
+		`n=40,n=1`,
+		`n=1,n=3`,
+		`n=3+cn=foo,n=1`,
+		`=3,n=1`,
+		`n=,n=1`,
+		`dotNotation=1.03`,
+	} {
+		if _, err := NewDotNotationFromDN(bogus); err == nil {
+			t.Errorf("%s failed: bogus DN '%s' parsed without error", t.Name(), bogus)
+		}
+	}
+
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521.999`)
+	if rt, err := NewDotNotationFromDN(dot.DN(``)); err != nil || rt.String() != dot.String() {
+		t.Errorf("%s failed: round trip failed: %v", t.Name(), err)
+	}
+}
@@ -9,6 +9,8 @@ Package objectid implements ASN.1 Object Identifier types and methods.
   - Convenient Leaf, Parent and Root index alias methods, wherever applicable
   - Ge, Gt, Le, Lt, Equal comparison methods for interacting with [NumberForm] instances
   - Conversion friendly -- easy hand-off to [encoding/asn1.ObjectIdentifier] and [crypto/x509.OID] instances
+  - OID-IRI support by way of the [IRINotation] type, per ITU-T Rec. X.660
+  - [Registry] type for storage of OID registrations, with compact binary serialization
 
 # License
 
@@ -33,6 +35,10 @@ ASN.1 codec features are written solely for [DotNotation] related cases (e.g.: "
 
 Encoding of non-minimal values -- such as root arcs "0", "1" and "2" alone -- is not supported.  Some ASN.1 implementations precariously treat certain OIDs, such as "0" and "0.0" the same, likely for support reasons. This results in ambiguity when handling pre-encoded bytes in an obverse scenario, and is in violation of ITU-T Rec. X.690 regarding the proper encoding of an ASN.1 OBJECT IDENTIFIER.
 
-In short, codec functions will only operate successfully when given [DotNotation] comprised of two (2) or more [NumberForm] instances.
+In short, codec functions will only operate successfully when given [DotNotation] comprised of two (2) or more [NumberForm] instances. The [AllowSubMinimal] option relaxes this for interoperability with legacy peers, at the cost of conformance.
+
+# Concurrency
+
+All methods of the [DotNotation], [ASN1Notation], [OID] and [NumberForm] types are safe for concurrent use, with the exception of those which are documented to modify the receiver, such as [DotNotation.Decode] and [DotNotation.SetIndex]. No read-only method writes through its receiver, and no slice returned by a read-only method can be appended to in a manner that alters the receiver. The package-wide name dictionary and Unicode label registry are likewise safe for concurrent use.
 */
 package objectid
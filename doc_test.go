@@ -2,6 +2,8 @@ package objectid
 
 import (
 	"fmt"
+	"sync"
+	"testing"
 )
 
 func ExampleNewNumberForm() {
@@ -116,24 +118,49 @@ func ExampleDotNotation_Uint64Slice() {
 	// Output: [1 3 6 1 4 1 56521 9999999999999999999 5]
 }
 
+func ExampleDotNotation_Uint32Slice() {
+	dot, _ := NewDotNotation(`1.3.6.1.2.1.1.3.0`)
+
+	slice, err := dot.Uint32Slice()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%v", slice)
+	// Output: [1 3 6 1 2 1 1 3 0]
+}
+
+func ExampleDotNotation_Uint32Slice_overflow() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.4294967296.1`)
+	slice, err := dot.Uint32Slice()
+	if err != nil {
+		fmt.Println(slice, err)
+		return
+	}
+	// Output: [1 3 6 1 4 1] Arc 6 (4294967296) overflow: strconv.ParseUint: parsing "4294967296": value out of range
+}
+
 func ExampleDotNotation_IntSlice_overflow() {
 	a := `2.25.987895962269883002155146617097157934`
 	dot, _ := NewDotNotation(a)
-	if _, err := dot.IntSlice(); err != nil {
-		fmt.Println(err)
+	slice, err := dot.IntSlice()
+	if err != nil {
+		fmt.Println(slice, err)
 		return
 	}
-	// Output: strconv.Atoi: parsing "987895962269883002155146617097157934": value out of range
+	// Output: [2 25] Arc 2 (987895962269883002155146617097157934) overflow: strconv.Atoi: parsing "987895962269883002155146617097157934": value out of range
 }
 
 func ExampleDotNotation_Uint64Slice_overflow() {
 	a := `2.25.987895962269883002155146617097157934`
 	dot, _ := NewDotNotation(a)
-	if _, err := dot.Uint64Slice(); err != nil {
-		fmt.Println(err)
+	slice, err := dot.Uint64Slice()
+	if err != nil {
+		fmt.Println(slice, err)
 		return
 	}
-	// Output: strconv.ParseUint: parsing "987895962269883002155146617097157934": value out of range
+	// Output: [2 25] Arc 2 (987895962269883002155146617097157934) overflow: strconv.ParseUint: parsing "987895962269883002155146617097157934": value out of range
 }
 
 func ExampleDotNotation_Ancestry() {
@@ -191,3 +218,68 @@ func ExampleDotNotation_AncestorOf() {
 	fmt.Printf("%t", dot.AncestorOf(child))
 	// Output: false
 }
+
+/*
+TestConcurrentReaders exercises the read-only methods of the principal
+types from many goroutines at once, and is meaningful when run with the
+race detector (go test -race).
+*/
+func TestConcurrentReaders(t *testing.T) {
+	dot, _ := NewDotNotation(`2.25.987895962269883002155146617097157934.1`)
+	asn, _ := NewASN1Notation(`{iso(1) identified-organization(3) dod(6) internet(1)}`)
+	oid, _ := NewOID(`{iso(1) identified-organization(3) dod(6) internet(1)}`)
+	want, _ := dot.Encode()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if b, err := dot.Encode(); err != nil || string(b) != string(want) {
+					t.Errorf("%s failed: bad encoding", t.Name())
+				}
+				_, _, _ = dot.Leaf().Uint128()
+				_ = dot.Ancestry()
+				_ = dot.IRI().String()
+				_ = dot.ASN().String()
+				_ = dot.Equal(oid)
+				_ = dot.HasPrefix(`2.25`)
+				_ = asn.Ancestry()
+				_ = asn.Dot().String()
+				_ = asn.Equal(dot)
+				_, _ = oid.Strings()
+				_ = oid.ASN()
+				_ = oid.Valid()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if dot.String() != `2.25.987895962269883002155146617097157934.1` {
+		t.Errorf("%s failed: receiver altered: %s", t.Name(), dot)
+	}
+}
+
+func TestReadOnlyResultsDoNotAlias(t *testing.T) {
+	dot, _ := NewDotNotation(`1.3.6.1`)
+	nf, _ := NewNumberForm(99)
+
+	anc := dot.Ancestry()
+	_ = append(anc[1], nf)
+	dot.AncestryFunc(func(d DotNotation) bool {
+		_ = append(d, nf)
+		return true
+	})
+
+	asn, _ := NewASN1Notation(`{iso(1) identified-organization(3) dod(6) internet(1)}`)
+	_ = append(asn.Ancestry()[1], NameAndNumberForm{})
+
+	oid, _ := NewOID(`{iso(1) identified-organization(3) dod(6) internet(1)}`)
+	a := oid.ASN()
+	a[0] = NameAndNumberForm{}
+
+	if dot.String() != `1.3.6.1` || asn.String() != `{iso(1) identified-organization(3) dod(6) internet(1)}` || !oid.Valid() {
+		t.Errorf("%s failed: receiver altered through returned slice", t.Name())
+	}
+}
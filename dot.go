@@ -1,6 +1,9 @@
 package objectid
 
-import "math/big"
+import (
+	"math"
+	"math/big"
+)
 
 /*
 DotNotation contains an ordered sequence of [NumberForm] instances.
@@ -13,12 +16,42 @@ receiver (e.g.: "1.3.6.1").
 */
 func (r DotNotation) String() (s string) {
 	if !r.IsZero() {
-		var x []string
+		b, _ := r.AppendText(make([]byte, 0, 8*len(r)))
+		s = string(b)
+	}
+	return
+}
+
+/*
+StringSep returns the arcs of the receiver delimited by sep rather than a
+dot (e.g.: "1 3 6 1" given a sep of " "), for tools requiring alternative
+textual layouts. A zero string is returned if the receiver is zero.
+*/
+func (r DotNotation) StringSep(sep string) (s string) {
+	if !r.IsZero() {
+		var b []byte
 		for i := 0; i < len(r); i++ {
-			x = append(x, r[i].String())
+			if i > 0 {
+				b = append(b, sep...)
+			}
+			b = r[i].AppendDecimal(b)
 		}
+		s = string(b)
+	}
+	return
+}
+
+/*
+StringLeadingDot returns the dot notation form of the receiver bearing a
+leading dot (e.g.: ".1.3.6.1.2.1"), per the conventions of net-snmp and
+related SNMP tooling. A zero string is returned if the receiver is zero.
 
-		s = join(x, `.`)
+Such values are accepted by [NewDotNotation] as-is.
+*/
+func (r DotNotation) StringLeadingDot() (s string) {
+	if !r.IsZero() {
+		b, _ := r.AppendText([]byte{'.'})
+		s = string(b)
 	}
 	return
 }
@@ -35,6 +68,30 @@ func (r DotNotation) Len() int {
 	return len(r)
 }
 
+/*
+Depth returns the number of arcs present within the receiver. This is
+synonymous with [DotNotation.Len].
+*/
+func (r DotNotation) Depth() int {
+	return r.Len()
+}
+
+/*
+IsRootArc returns a Boolean value indicative of whether the receiver
+consists of a single arc.
+*/
+func (r DotNotation) IsRootArc() bool {
+	return r.Depth() == 1
+}
+
+/*
+IsSecondLevel returns a Boolean value indicative of whether the receiver
+consists of exactly two (2) arcs, such as 2.999.
+*/
+func (r DotNotation) IsSecondLevel() bool {
+	return r.Depth() == 2
+}
+
 /*
 Leaf returns the leaf-node (-1) [NumberForm] instance.
 */
@@ -77,13 +134,42 @@ each treated as an individual [NumberForm] instance:
 
 If a string primitive is the only input option, it will be treated as a
 complete [DotNotation] (e.g.: "1.3.6").
+
+Instances of [ParseOption] may also be provided, and are not considered
+input values. By default, arcs bearing leading zeros (e.g.: "1.03.6") are
+normalized (e.g.: "1.3.6"), meaning the String method will not reproduce
+the original input. See [RejectLeadingZeros] to alter this behavior.
+
+By default, whitespace is tolerated only where it delimits arcs, namely
+between the arcs of the space-delimited and braced forms listed below,
+within and around the braces of the latter (e.g.: " { 1 3 6 }\n"). The
+dotted forms admit no whitespace whatsoever (e.g.: " 1.3.6" and "1. 3.6"
+are rejected), nor does the space-delimited form beyond its arcs (e.g.:
+" 1 3 6" is rejected). See [AllowWhitespace] for a more lenient
+alternative.
+
+The following common spellings of a string [DotNotation] are recognized
+and normalized automatically:
+
+  - URN form (e.g.: "urn:oid:1.3.6.1")
+  - RFC 1779 "OID." form (e.g.: "OID.1.3.6.1")
+  - Braced numeric ASN.1 value form (e.g.: "{1 3 6 1}")
+  - Space-delimited numeric form (e.g.: "1 3 6 1")
+  - Leading-dot SNMP form (e.g.: ".1.3.6.1")
 */
 func NewDotNotation(x ...any) (r *DotNotation, err error) {
+	defer countOp(&opStats.parses, &opStats.parseFailures, &err)
+
 	var _d DotNotation = make(DotNotation, 0)
 
+	x, opts := splitParseOptions(x)
+	cfg := newParseConfig(opts...)
+
 	if len(x) == 1 {
 		if slice, ok := x[0].(string); ok {
-			r, err = newDotNotationStr(slice)
+			if r, err = newDotNotationStr(slice, cfg); err == nil && cfg.intern {
+				*r = Intern(*r)
+			}
 			return
 		}
 	}
@@ -97,7 +183,11 @@ func NewDotNotation(x ...any) (r *DotNotation, err error) {
 				break
 			}
 			nf = tv
-		case *big.Int, string, uint64, uint, int:
+		case string:
+			if tv, err = cfg.prepareArc(tv); err == nil {
+				nf, err = newNumberForm(tv, cfg)
+			}
+		case *big.Int, uint64, uint, int:
 			nf, err = NewNumberForm(tv)
 		default:
 			err = errorf("Unsupported slice type '%T' for OID", tv)
@@ -107,6 +197,9 @@ func NewDotNotation(x ...any) (r *DotNotation, err error) {
 	}
 
 	if err == nil {
+		if cfg.intern {
+			_d = Intern(_d)
+		}
 		r = new(DotNotation)
 		*r = _d
 	}
@@ -114,22 +207,34 @@ func NewDotNotation(x ...any) (r *DotNotation, err error) {
 	return
 }
 
-func newDotNotationStr(dot string) (r *DotNotation, err error) {
-	if !isNumericOID(dot) {
-		err = errorf("Invalid OID '%s' cannot be processed", dot)
-		return
+/*
+MustNewDotNotation returns an instance of *[DotNotation] in the manner of
+[NewDotNotation], but panics if an error is encountered. It is intended
+for the initialization of package variables bearing known-good values,
+such as those produced by [Registry.ExportGo].
+*/
+func MustNewDotNotation(x ...any) *DotNotation {
+	r, err := NewDotNotation(x...)
+	if err != nil {
+		panic(err)
 	}
-	z := split(dot, `.`)
 
-	_d := make(DotNotation, 0)
-	for j := 0; j < len(z) && err == nil; j++ {
-		var nf NumberForm
-		if nf, err = NewNumberForm(z[j]); err == nil {
-			_d = append(_d, nf)
-		}
+	return r
+}
+
+func newDotNotationStr(dot string, cfg *parseConfig) (r *DotNotation, err error) {
+	if cfg.whitespace {
+		dot = trimS(dot)
 	}
 
-	if err == nil {
+	if dot, err = stripDotPrefix(dot); err != nil {
+		return
+	} else if dot, err = cfg.prepareDot(dot); err != nil {
+		return
+	}
+
+	var _d DotNotation
+	if _d, err = parseDotString(dot, cfg); err == nil {
 		r = new(DotNotation)
 		*r = _d
 	}
@@ -138,54 +243,235 @@ func newDotNotationStr(dot string) (r *DotNotation, err error) {
 }
 
 /*
-Encode returns the ASN.1 encoding of the receiver instance alongside an error.
+parseDotString returns an instance of [DotNotation] parsed from the dot
+notation string dot in a single scan, alongside an error. Arcs which fit
+within a uint64 are accumulated directly, while larger arcs fall back to
+[math/big.Int] parsing. The result must satisfy [DotNotation.Validate].
 */
-func (r DotNotation) Encode() (b []byte, err error) {
-	if r.Len() < 2 {
-		err = errorf("Length below encoding minimum")
+func parseDotString(dot string, cfg *parseConfig) (d DotNotation, err error) {
+	if len(dot) == 0 {
+		err = errorf("Zero length OID")
 		return
 	}
 
-	var start int
-	firstArc := r[0].cast()
-	forty := big.NewInt(40)
-	firstArc.Mul(firstArc, forty)
+	d = make(DotNotation, 0, count(dot, `.`)+1)
+
+	var (
+		start    int
+		n        uint64
+		overflow bool
+	)
+
+	for i := 0; i <= len(dot); i++ {
+		if i == len(dot) || dot[i] == '.' {
+			arc := dot[start:i]
+			if len(arc) == 0 {
+				err = errorf("Invalid OID '%s': empty arc at position %d", dot, i)
+				return
+			} else if err = cfg.checkArc(arc); err != nil {
+				return
+			}
 
-	if r[1].cast().Cmp(forty) < 1 {
-		// this is meant for second level arcs <= 39
-		firstArc.Add(firstArc, r[1].cast()) // first + arc2
-		if firstBytes := firstArc.Bytes(); len(firstBytes) == 0 {
-			// We need the explicit zero byte, not an
-			// empty []byte{} instance.  This effort
-			// is really needed for OID "0.0".
-			b = append([]byte{0x00}, b...)
-		} else {
-			b = append(firstBytes, b...)
+			var x *big.Int
+			if overflow {
+				x, _ = big.NewInt(0).SetString(arc, 10)
+			} else {
+				x = big.NewInt(0).SetUint64(n)
+			}
+			d = append(d, NumberForm(*x))
+			start, n, overflow = i+1, 0, false
+			continue
 		}
-		start = 2
-	} else {
-		if r[0].cast().Uint64() != 2 {
-			err = errorf("Only joint-iso-itu-t(2) OIDs allow second-level arcs > 39")
+
+		c := dot[i]
+		if c < '0' || '9' < c {
+			err = errorf("Invalid OID '%s': disallowed character at position %d", dot, i)
+			return
+		}
+
+		if !overflow {
+			if n > (math.MaxUint64-9)/10 {
+				overflow = true
+			} else {
+				n = n*10 + uint64(c-'0')
+			}
+		}
+	}
+
+	if err = d.Validate(); err != nil {
+		d = nil
+	}
+
+	return
+}
+
+/*
+stripDotPrefix returns dot following the removal of any recognized prefix
+or enclosure, such as "urn:oid:", "OID.", a single leading dot, or curly
+braces surrounding a whitespace-delimited sequence of numbers, as with the
+ASN.1 value notation "{ 1 3 6 1 4 1 56521 }". Whitespace surrounding such
+braces is ignored, as values copied from specifications often bear it.
+Unbraced whitespace-delimited sequences of numbers are also converted.
+*/
+func stripDotPrefix(dot string) (out string, err error) {
+	out = dot
+	braced := trimS(dot)
+	switch {
+	case len(braced) >= 2 && braced[0] == '{' && braced[len(braced)-1] == '}':
+		out, err = joinNumericFields(braced[1 : len(braced)-1])
+	case indexFunc(dot, isSpace) != -1 && !contains(dot, `.`) && dot == trimS(dot):
+		out, err = joinNumericFields(dot)
+	case len(dot) > 8 && eq(dot[:8], `urn:oid:`):
+		out = dot[8:]
+	case len(dot) > 4 && eq(dot[:4], `oid.`):
+		out = dot[4:]
+	case len(dot) > 1 && dot[0] == '.' && dot[1] != '.':
+		out = dot[1:]
+	}
+
+	return
+}
+
+/*
+joinNumericFields returns the whitespace-delimited numbers within val
+joined using dots, alongside an error if any field is non-numeric.
+*/
+func joinNumericFields(val string) (out string, err error) {
+	arcs := fields(val)
+	for i := 0; i < len(arcs); i++ {
+		if !isNumber(arcs[i]) {
+			err = errorf("Numeric OID value contains non-numeric arc '%s'", arcs[i])
 			return
 		}
+	}
+	out = join(arcs, `.`)
+
+	return
+}
 
-		// Multi-Byte encoding for second-level arcs
-		// below joint-iso-itu-t(2), such as "999" for
-		// "2.999", that are larger than 39.  Instead,
-		// skip the Addition operation we'd normally
-		// perform, and just begin VLQ encoding each
-		// subsequent byte.
-		start = 1
+/*
+AppendText appends the dot notation string representation of the receiver
+(e.g.: "1.3.6.1") to b, returning the extended buffer alongside an error.
+This satisfies the [encoding.TextAppender] interface.
+
+An error is returned if the receiver is zero length.
+*/
+func (r DotNotation) AppendText(b []byte) ([]byte, error) {
+	if r.Len() == 0 {
+		return b, errorf("Cannot append text of zero %T", r)
 	}
 
-	if len(r) > start {
-		for i := start; i < len(r); i++ {
-			b = append(b, encodeVLQ(r[i].cast().Bytes())...)
+	for i := 0; i < r.Len(); i++ {
+		if i > 0 {
+			b = append(b, '.')
 		}
+		b = r[i].AppendDecimal(b)
+	}
+
+	return b, nil
+}
+
+/*
+AppendBinary appends the ASN.1 encoding of the receiver, as produced by
+the [DotNotation.Encode] method, to b, returning the extended buffer
+alongside an error. This satisfies the [encoding.BinaryAppender] interface.
+*/
+func (r DotNotation) AppendBinary(b []byte) ([]byte, error) {
+	enc, err := r.Encode()
+	if err != nil {
+		return b, err
 	}
 
-	b = append([]byte{byte(len(b))}, b...) // byte representation of int length of byte slice b
-	b = append([]byte{0x06}, b...)         // ASN.1 Object Identifier Tag (0x06)
+	return append(b, enc...), nil
+}
+
+/*
+LessDER returns a Boolean value indicative of whether a sorts before b
+when ordered by their ASN.1 encodings, as required of the components of
+a SET OF value per ITU-T Rec. X.690 clause 11.6. Encodings are compared
+as octet strings, the shorter being padded at its trailing end with zero
+octets. This function is suitable for use with [sort.Slice].
+
+Values which cannot be encoded sort after all values which can.
+*/
+func LessDER(a, b DotNotation) bool {
+	ea, erra := a.Encode()
+	eb, errb := b.Encode()
+	if erra != nil || errb != nil {
+		return erra == nil && errb != nil
+	}
+
+	for i := 0; i < len(ea) || i < len(eb); i++ {
+		var x, y byte
+		if i < len(ea) {
+			x = ea[i]
+		}
+		if i < len(eb) {
+			y = eb[i]
+		}
+
+		if x != y {
+			return x < y
+		}
+	}
+
+	return false
+}
+
+/*
+Encode returns the ASN.1 encoding of the receiver instance alongside an error.
+
+Zero or more instances of [EncodingOption] may be provided to alter the
+encoding, such as through use of [WithImplicitTag].
+
+So that no encoding is produced which [DotNotation.Decode] would reject,
+an error is returned if the contents would exceed [DefaultMaxContentLength]
+octets. Use [WithMaxContentLength] to raise or remove this limit.
+*/
+func (r DotNotation) Encode(opts ...EncodingOption) (b []byte, err error) {
+	defer countOp(&opStats.encodes, &opStats.encodeFailures, &err)
+
+	cfg := newEncodingConfig(opts...)
+	if err = cfg.err; err != nil {
+		return
+	}
+
+	if r.Len() == 1 && cfg.subMinimal && r[0].cast().Cmp(big.NewInt(3)) < 0 {
+		// Non-conformant root arc alone, encoded as
+		// though the second-level arc were zero (0).
+		r = DotNotation{r[0], NumberForm{}}
+	} else if r.Len() < 2 {
+		err = errorf("Length below encoding minimum")
+		return
+	}
+
+	// The first two arcs are combined into a single subidentifier
+	// per ITU-T Rec. X.690 clause 8.19.4, with a value of (40*X)+Y.
+	// Only joint-iso-itu-t(2) permits second-level arcs above 39.
+	if r[0].cast().Cmp(big.NewInt(2)) > 0 {
+		err = errorf("Root arc exceeds joint-iso-itu-t(2)")
+		return
+	} else if r[0].cast().Cmp(big.NewInt(2)) < 0 && r[1].cast().Cmp(big.NewInt(39)) > 0 {
+		err = errorf("Only joint-iso-itu-t(2) OIDs allow second-level arcs > 39")
+		return
+	}
+
+	first := r[0].clone()
+	first.Mul(first, big.NewInt(40))
+	first.Add(first, r[1].cast())
+	b = append(b, encodeVLQ(first.Bytes())...)
+
+	for i := 2; i < len(r); i++ {
+		b = append(b, encodeVLQ(r[i].cast().Bytes())...)
+	}
+
+	if err = cfg.checkContentLength(len(b)); err != nil {
+		return
+	}
+
+	// ASN.1 Object Identifier Tag (0x06), unless overridden,
+	// followed by the length octets and the contents octets.
+	b = append(append([]byte{cfg.tag}, encodeLength(len(b))...), b...)
 
 	return
 }
@@ -194,35 +480,251 @@ func (r DotNotation) Encode() (b []byte, err error) {
 Decode returns an error following an attempt to parse b, which must be
 the ASN.1 encoding of an OID, into the receiver instance. The receiver
 instance is reinitialized at runtime.
+
+Zero or more instances of [EncodingOption] may be provided to alter the
+decoding, such as through use of [WithImplicitTag] when the encoding was
+extracted from an implicitly tagged field.
+
+To guard against hostile input, encodings whose contents exceed
+[DefaultMaxContentLength] octets, or which bear any subidentifier longer
+than [DefaultMaxSubidentifierOctets] octets, are rejected. These limits
+may be adjusted using [WithMaxContentLength] and [WithMaxSubidentifierOctets].
+*/
+func (r *DotNotation) Decode(b []byte, opts ...EncodingOption) (err error) {
+	defer countOp(&opStats.decodes, &opStats.decodeFailures, &err)
+
+	cfg := newEncodingConfig(opts...)
+	if err = cfg.err; err != nil {
+		return
+	}
+
+	var content, rest []byte
+	if content, rest, err = readOIDTLV(b, cfg); err != nil {
+		return
+	} else if len(rest) > 0 {
+		err = errorf("Length of bytes does not match with the indicated length")
+		return
+	}
+
+	var d DotNotation
+	if d, err = decodeContent(content, cfg); err == nil {
+		*r = d
+	}
+
+	return
+}
+
+/*
+MarshalBinary implements the [encoding.BinaryMarshaler] interface, returning
+the ASN.1 encoding of the receiver as produced by [DotNotation.Encode] with
+default options. This allows the receiver to be used with [encoding/gob]
+and other frameworks which consume the standard binary interfaces.
+*/
+func (r DotNotation) MarshalBinary() ([]byte, error) {
+	return r.Encode()
+}
+
+/*
+UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface,
+setting the receiver to the OID decoded from the ASN.1 encoding b, as with
+[DotNotation.Decode] using default options.
 */
-func (r *DotNotation) Decode(b []byte) (err error) {
+func (r *DotNotation) UnmarshalBinary(b []byte) error {
+	return r.Decode(b)
+}
+
+/*
+DecodeNext returns an instance of [DotNotation] decoded from the first
+ASN.1 OBJECT IDENTIFIER TLV found at the front of b, alongside the bytes
+remaining beyond that TLV and an error.
+
+This function allows the codec to be used incrementally, such as when
+processing a buffer containing concatenated encodings, or when parsing
+an OID from within a larger hand-rolled DER structure.
+
+Zero or more instances of [EncodingOption] may be provided, as with the
+[DotNotation.Decode] method.
+*/
+func DecodeNext(b []byte, opts ...EncodingOption) (d DotNotation, rest []byte, err error) {
+	defer countOp(&opStats.decodes, &opStats.decodeFailures, &err)
+
+	cfg := newEncodingConfig(opts...)
+	if err = cfg.err; err != nil {
+		return
+	}
+
+	var content []byte
+	if content, rest, err = readOIDTLV(b, cfg); err == nil {
+		if d, err = decodeContent(content, cfg); err != nil {
+			rest = nil
+		}
+	}
+
+	return
+}
+
+/*
+CheckEncoding returns an error if b is not a well-formed ASN.1 encoding
+of an OID. The tag, length octets and contents octets are verified, with
+each subidentifier required to be minimally encoded (i.e.: not begin with
+the octet 0x80) and terminated. No trailing bytes are permitted. The
+limits described by [WithMaxContentLength] and [WithMaxSubidentifierOctets]
+are also enforced.
+
+Unlike [DotNotation.Decode], no [DotNotation] is allocated, making this
+function suitable for the fast pre-screening of untrusted DER input.
+
+Zero or more instances of [EncodingOption] may be provided, as with the
+[DotNotation.Decode] method.
+*/
+func CheckEncoding(b []byte, opts ...EncodingOption) (err error) {
+	cfg := newEncodingConfig(opts...)
+	if err = cfg.err; err != nil {
+		return
+	}
+
+	var content, rest []byte
+	if content, rest, err = readOIDTLV(b, cfg); err != nil {
+		return
+	} else if len(rest) > 0 {
+		err = errorf("Length of bytes does not match with the indicated length")
+		return
+	}
+
+	start, octets := true, 0
+	for i := 0; i < len(content); i++ {
+		if start && content[i] == 0x80 {
+			err = errorf("Non-minimal subidentifier encoding at offset %d", i)
+			return
+		}
+
+		octets++
+		if err = cfg.checkSubidOctets(octets); err != nil {
+			return
+		}
+
+		if start = content[i]&0x80 == 0; start {
+			octets = 0
+		}
+	}
+
+	if !start {
+		err = errorf("Truncated OID subidentifier")
+	}
+
+	return
+}
+
+/*
+readOIDTLV verifies the tag and length of the ASN.1 OBJECT IDENTIFIER
+encoding at the front of b, returning its contents octets alongside the
+remaining bytes and an error. The tag is typically 0x06, unless altered
+through an EncodingOption. Lengths exceeding the maximum content length
+of cfg are rejected.
+*/
+func readOIDTLV(b []byte, cfg *encodingConfig) (content, rest []byte, err error) {
+	tag := cfg.tag
 	if len(b) < 3 {
 		err = errorf("Truncated OID encoding")
 		return
 	}
 
-	if b[0] != 0x06 {
-		err = errorf("Invalid ASN.1 Tag; want: 0x06")
+	if b[0] != tag {
+		err = errorf("Invalid ASN.1 Tag; want: 0x%02x", tag)
+		return
+	}
+
+	var length, n int
+	if length, n, err = readLength(b[1:]); err != nil {
 		return
 	}
 
-	length := int(b[1])
-	b = b[2:]
+	if err = cfg.checkContentLength(length); err != nil {
+		return
+	}
 
-	if length != len(b) {
+	b = b[1+n:]
+	if length == 0 || length > len(b) {
 		err = errorf("Length of bytes does not match with the indicated length")
 		return
 	}
 
+	content, rest = b[:length], b[length:]
+
+	return
+}
+
+/*
+encodeLength returns the ASN.1 definite length octets for length, using
+the short form where possible and the long form otherwise.
+*/
+func encodeLength(length int) (b []byte) {
+	if length < 0x80 {
+		return []byte{byte(length)}
+	}
+
+	for ; length > 0; length >>= 8 {
+		b = append([]byte{byte(length)}, b...)
+	}
+
+	return append([]byte{0x80 | byte(len(b))}, b...)
+}
+
+/*
+readLength returns the integer length indicated by the ASN.1 length octets
+at the front of b, alongside the number of octets read and an error. Both
+short and long definite forms are supported.
+*/
+func readLength(b []byte) (length, n int, err error) {
+	if len(b) == 0 {
+		err = errorf("Truncated OID encoding")
+		return
+	} else if b[0]&0x80 == 0 {
+		length, n = int(b[0]), 1
+		return
+	}
+
+	octets := int(b[0] & 0x7F)
+	if octets == 0 {
+		err = errorf("Indefinite length encoding is not permitted for an OID")
+		return
+	} else if octets > 4 {
+		err = errorf("Encoded length exceeds supported maximum")
+		return
+	} else if len(b) < octets+1 {
+		err = errorf("Truncated OID encoding")
+		return
+	}
+
+	for i := 1; i <= octets; i++ {
+		length = length<<8 | int(b[i])
+	}
+	n = octets + 1
+
+	return
+}
+
+/*
+decodeContent returns an instance of [DotNotation] decoded from the
+contents octets of an ASN.1 OBJECT IDENTIFIER, alongside an error.
+Subidentifiers exceeding the maximum octet count of cfg are rejected.
+*/
+func decodeContent(b []byte, cfg *encodingConfig) (r DotNotation, err error) {
 	var (
 		i             int
 		subidentifier *big.Int = big.NewInt(0)
 	)
 
-	*r = make(DotNotation, 0)
+	r = make(DotNotation, 0)
 
 	for i < len(b) {
-		for {
+		for start := i; ; {
+			if i >= len(b) {
+				err = errorf("Truncated OID subidentifier")
+				return
+			} else if err = cfg.checkSubidOctets(i - start + 1); err != nil {
+				return
+			}
 			subidentifier.Lsh(subidentifier, 7)
 			subidentifier.Add(subidentifier, big.NewInt(int64(b[i]&0x7F)))
 			if b[i]&0x80 == 0 {
@@ -232,64 +734,119 @@ func (r *DotNotation) Decode(b []byte) (err error) {
 		}
 
 		i++
-		*r = append(*r, NumberForm(*subidentifier))
+		r = append(r, NumberForm(*subidentifier))
 		subidentifier = big.NewInt(0)
 	}
 
-	if len(*r) > 0 {
-		r.decodeFirstArcs(b[0])
+	if len(r) > 0 {
+		r.decodeFirstArcs()
+		if cfg.subMinimal && len(b) == 1 && r[1].cast().Sign() == 0 {
+			// Non-conformant root arc alone; see
+			// the AllowSubMinimal option.
+			r = r[:1:1]
+		}
 	}
 
 	return
 }
 
-func (r *DotNotation) decodeFirstArcs(b byte) {
-	var firstArc *big.Int
-	var secondArc *big.Int
-
-	var forty *big.Int = big.NewInt(40)
-	var eighty *big.Int = big.NewInt(80)
+/*
+decodeFirstArcs splits the first subidentifier of the receiver into the
+root and second-level arcs it encodes, per ITU-T Rec. X.690 clause 8.19.4.
+Values of 80 or more denote joint-iso-itu-t(2), whose second-level arc may
+exceed 39.
+*/
+func (r *DotNotation) decodeFirstArcs() {
+	var (
+		sub       = (*r)[0].cast()
+		firstArc  = big.NewInt(2)
+		secondArc = new(big.Int)
+		eighty    = big.NewInt(80)
+	)
 
-	if (*r)[0].cast().Cmp(big.NewInt(80)) < 0 {
-		firstArc = big.NewInt(0).Div((*r)[0].cast(), forty)
-		secondArc = big.NewInt(0).Mod((*r)[0].cast(), forty)
+	if sub.Cmp(eighty) < 0 {
+		firstArc.DivMod(sub, big.NewInt(40), secondArc)
 	} else {
-		firstArc = big.NewInt(2)
-		if b >= 0x80 {
-			// Handle large second-level arcs
-			secondArc = big.NewInt(0).Sub((*r)[0].cast(), firstArc)
-			secondArc.Add(secondArc, firstArc)
-		} else {
-			secondArc = big.NewInt(0).Sub((*r)[0].cast(), eighty)
-		}
+		secondArc.Sub(sub, eighty)
 	}
 
 	(*r)[0] = NumberForm(*secondArc)
 	*r = append(DotNotation{NumberForm(*firstArc)}, *r...)
 }
 
+/*
+OverflowError is returned by the [DotNotation.IntSlice],
+[DotNotation.Uint32Slice] and [DotNotation.Uint64Slice] methods when an
+arc cannot be represented by the native integer type in question.
+*/
+type OverflowError struct {
+	// Index contains the index of the offending arc.
+	Index int
+
+	// Arc contains the offending arc.
+	Arc NumberForm
+
+	// Err contains the underlying conversion error.
+	Err error
+}
+
+/*
+Error returns the string representation of the receiver.
+*/
+func (r *OverflowError) Error() string {
+	return sprintf("Arc %d (%s) overflow: %v", r.Index, r.Arc, r.Err)
+}
+
+/*
+Unwrap returns the underlying conversion error.
+*/
+func (r *OverflowError) Unwrap() error {
+	return r.Err
+}
+
 /*
 IntSlice returns slices of integer values and an error. The integer values are based
-upon the contents of the receiver. Note that if any single arc number overflows int,
-a zero slice is returned.
+upon the contents of the receiver.
+
+Note that if any single arc number overflows int, the arcs converted up to
+that point are returned alongside an instance of *[OverflowError] identifying
+the offending arc. This allows callers to degrade gracefully, such as when
+handling 2.25 UUID-based OIDs.
 
 Successful output can be cast as an instance of [encoding/asn1.ObjectIdentifier], if desired.
 */
 func (r DotNotation) IntSlice() (slice []int, err error) {
-	if r.IsZero() {
-		return
-	}
-
-	var t []int
 	for i := 0; i < len(r); i++ {
 		var n int
 		if n, err = atoi(r[i].String()); err != nil {
+			err = &OverflowError{Index: i, Arc: r[i], Err: err}
 			return
 		}
-		t = append(t, n)
+		slice = append(slice, n)
 	}
-	if len(t) > 0 {
-		slice = t[:]
+
+	return
+}
+
+/*
+Uint32Slice returns slices of uint32 values and an error. The uint32
+values are based upon the contents of the receiver.
+
+Note that if any single arc number overflows uint32, the arcs converted
+up to that point are returned alongside an instance of *[OverflowError]
+identifying the offending arc.
+
+Successful output is suitable for the many SNMP and network management
+libraries which model OIDs as []uint32.
+*/
+func (r DotNotation) Uint32Slice() (slice []uint32, err error) {
+	for i := 0; i < len(r); i++ {
+		var n uint64
+		if n, err = puint64(r[i].String(), 10, 32); err != nil {
+			err = &OverflowError{Index: i, Arc: r[i], Err: err}
+			return
+		}
+		slice = append(slice, uint32(n))
 	}
 
 	return
@@ -299,27 +856,21 @@ func (r DotNotation) IntSlice() (slice []int, err error) {
 Uint64Slice returns slices of uint64 values and an error. The uint64
 values are based upon the contents of the receiver.
 
-Note that if any single arc number overflows uint64, a zero slice is
-returned alongside an error.
+Note that if any single arc number overflows uint64, the arcs converted
+up to that point are returned alongside an instance of *[OverflowError]
+identifying the offending arc.
 
 Successful output can be cast as an instance of [crypto/x509.OID], if
 desired.
 */
 func (r DotNotation) Uint64Slice() (slice []uint64, err error) {
-	if r.IsZero() {
-		return
-	}
-
-	var t []uint64
 	for i := 0; i < len(r); i++ {
 		var n uint64
 		if n, err = puint64(r[i].String(), 10, 64); err != nil {
+			err = &OverflowError{Index: i, Arc: r[i], Err: err}
 			return
 		}
-		t = append(t, n)
-	}
-	if len(t) > 0 {
-		slice = t[:]
+		slice = append(slice, n)
 	}
 
 	return
@@ -358,9 +909,215 @@ within the receiver is less than two (2) [NumberForm] values in length.
 func (r DotNotation) Ancestry() (anc []DotNotation) {
 	if r.Len() > 0 {
 		for i := r.Len(); i > 0; i-- {
-			anc = append(anc, r[:i])
+			anc = append(anc, r[:i:i])
+		}
+	}
+
+	return
+}
+
+/*
+AncestryFunc calls fn with each ancestral [DotNotation] of the receiver,
+ordered from leaf node (first) to root node (last), in the same manner as
+the [DotNotation.Ancestry] method. Iteration ceases once fn returns false.
+
+Unlike [DotNotation.Ancestry], no intermediate slices are materialized:
+each value passed to fn shares the receiver's underlying array, and should
+be copied if retained beyond the call.
+*/
+func (r DotNotation) AncestryFunc(fn func(DotNotation) bool) {
+	if fn == nil {
+		return
+	}
+
+	for i := r.Len(); i > 0; i-- {
+		if !fn(r[:i:i]) {
+			return
+		}
+	}
+}
+
+/*
+SetIndex replaces the [NumberForm] at index idx of the receiver with v,
+returning an error if the operation failed. This method supports the use
+of negative indices, such that -1 refers to the leaf node. Unlike the
+[DotNotation.Index] method, out-of-range indices are not clamped, and
+result in an error.
+
+Valid input types for v are those supported by [NewNumberForm].
+
+If the receiver is two (2) or more arcs in length, the replacement is only
+performed if the result satisfies [DotNotation.Validate]; otherwise the
+receiver is left unmodified.
+*/
+func (r *DotNotation) SetIndex(idx int, v any) (err error) {
+	if r == nil || r.Len() == 0 {
+		err = errorf("Cannot set index of zero %T", r)
+		return
+	}
+
+	L := r.Len()
+	if idx < 0 {
+		idx += L
+	}
+	if !(0 <= idx && idx < L) {
+		err = errorf("Index %d out of range for %T of length %d", idx, *r, L)
+		return
+	}
+
+	var nf NumberForm
+	if nf, err = NewNumberForm(v); err != nil {
+		return
+	}
+
+	old := (*r)[idx]
+	(*r)[idx] = nf
+	if L >= 2 {
+		if err = r.Validate(); err != nil {
+			(*r)[idx] = old
+		}
+	}
+
+	return
+}
+
+/*
+Insert returns a new instance of *[DotNotation] containing the contents of
+the receiver with v inserted at index idx, alongside an error. Subsequent
+arcs are shifted to the right. An index equal to the receiver's length
+appends v as a new leaf node, while negative indices are relative to the
+receiver's length, such that -1 inserts v before the leaf node.
+
+Valid input types for v are those supported by [NewNumberForm]. An error
+is returned if the result does not satisfy [DotNotation.Validate]. The
+receiver is never modified.
+*/
+func (r DotNotation) Insert(idx int, v any) (dot *DotNotation, err error) {
+	L := r.Len()
+	if idx < 0 {
+		idx += L
+	}
+	if !(0 <= idx && idx <= L) {
+		err = errorf("Index %d out of range for %T of length %d", idx, r, L)
+		return
+	}
+
+	var nf NumberForm
+	if nf, err = NewNumberForm(v); err != nil {
+		return
+	}
+
+	D := make(DotNotation, 0, L+1)
+	D = append(D, r[:idx]...)
+	D = append(D, nf)
+	D = append(D, r[idx:]...)
+
+	if err = D.Validate(); err == nil {
+		dot = &D
+	}
+
+	return
+}
+
+/*
+Remove returns a new instance of *[DotNotation] containing the contents of
+the receiver less the arc at index idx, alongside an error. Negative indices
+are supported, such that -1 removes the leaf node.
+
+An error is returned if the result does not satisfy [DotNotation.Validate].
+The receiver is never modified.
+*/
+func (r DotNotation) Remove(idx int) (dot *DotNotation, err error) {
+	L := r.Len()
+	if idx < 0 {
+		idx += L
+	}
+	if !(0 <= idx && idx < L) {
+		err = errorf("Index %d out of range for %T of length %d", idx, r, L)
+		return
+	}
+
+	D := make(DotNotation, 0, L-1)
+	D = append(D, r[:idx]...)
+	D = append(D, r[idx+1:]...)
+
+	if err = D.Validate(); err == nil {
+		dot = &D
+	}
+
+	return
+}
+
+/*
+NextSibling returns a new instance of *[DotNotation] identical to the
+receiver, except that the leaf arc is incremented by one (1), alongside
+an error. This is convenient for scanning adjacent registrations or for
+generating candidate allocations.
+
+An error is returned if the result does not satisfy [DotNotation.Validate].
+The receiver is never modified.
+*/
+func (r DotNotation) NextSibling() (*DotNotation, error) {
+	return r.sibling(1)
+}
+
+/*
+PreviousSibling returns a new instance of *[DotNotation] identical to the
+receiver, except that the leaf arc is decremented by one (1), alongside an
+error. An error is returned if the leaf arc is zero (0), or if the result
+does not satisfy [DotNotation.Validate]. The receiver is never modified.
+*/
+func (r DotNotation) PreviousSibling() (*DotNotation, error) {
+	return r.sibling(-1)
+}
+
+func (r DotNotation) sibling(delta int64) (dot *DotNotation, err error) {
+	if r.Len() < 2 {
+		err = errorf("%T of length %d has no siblings", r, r.Len())
+		return
+	}
+
+	leaf := big.NewInt(0).Add(r.Leaf().cast(), big.NewInt(delta))
+	if leaf.Sign() < 0 {
+		err = errorf("Leaf arc of %s has no previous sibling", r)
+		return
+	}
+
+	D := r.clone()
+	D[D.Len()-1] = NumberForm(*leaf)
+	if err = D.Validate(); err == nil {
+		dot = &D
+	}
+
+	return
+}
+
+/*
+FirstDifference returns the integer index of the first arc at which the
+receiver and the input value differ. The input value can be a string or
+[DotNotation].
+
+A value of -1 is returned if the receiver and the input value are equal,
+or if one is a prefix (ancestor) of the other. A value of zero (0) is
+returned if the input value cannot be parsed.
+*/
+func (r DotNotation) FirstDifference(dot any) (idx int) {
+	D := assertDotNot(dot)
+	if D == nil {
+		return
+	}
+
+	L := r.Len()
+	if D.Len() < L {
+		L = D.Len()
+	}
+
+	for idx = 0; idx < L; idx++ {
+		if !r[idx].Equal((*D)[idx]) {
+			return
 		}
 	}
+	idx = -1
 
 	return
 }
@@ -381,6 +1138,82 @@ func (r DotNotation) AncestorOf(dot any) (is bool) {
 	return
 }
 
+/*
+HasPrefix returns a Boolean value indicative of whether the leading arcs
+of the receiver match those of prefix, which can be string, [DotNotation]
+or *[DotNotation]. A prefix equal to the receiver is considered a match.
+
+This is the inverse of [DotNotation.AncestorOf], save that string input
+is compared arc by arc in place, without constructing an intermediate
+[DotNotation] instance.
+*/
+func (r DotNotation) HasPrefix(prefix any) (has bool) {
+	if r.IsZero() {
+		return
+	}
+
+	switch tv := prefix.(type) {
+	case string:
+		has = r.hasStringPrefix(tv)
+	case *DotNotation:
+		if tv != nil {
+			has = r.hasDotPrefix(*tv)
+		}
+	case DotNotation:
+		has = r.hasDotPrefix(tv)
+	}
+
+	return
+}
+
+func (r DotNotation) hasDotPrefix(p DotNotation) bool {
+	if p.Len() == 0 || p.Len() > r.Len() {
+		return false
+	}
+
+	for i := 0; i < p.Len(); i++ {
+		if r[i].cast().Cmp(p[i].cast()) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r DotNotation) hasStringPrefix(p string) bool {
+	if len(p) == 0 {
+		return false
+	}
+
+	var idx int
+	for start := 0; start <= len(p); idx++ {
+		end := start
+		for end < len(p) && p[end] != '.' {
+			if p[end] < '0' || '9' < p[end] {
+				return false
+			}
+			end++
+		}
+
+		if end == start || idx >= r.Len() {
+			return false
+		}
+
+		seg := p[start:end]
+		if u, err := puint64(seg, 10, 64); err == nil {
+			if x := r[idx].cast(); !x.IsUint64() || x.Uint64() != u {
+				return false
+			}
+		} else if !r[idx].Equal(seg) {
+			return false
+		}
+
+		start = end + 1
+	}
+
+	return true
+}
+
 /*
 ChildOf returns a Boolean value indicative of whether the receiver is
 a direct superior (parent) of the input value, which can be string or
@@ -414,6 +1247,72 @@ func (r DotNotation) SiblingOf(dot any) (sof bool) {
 	return
 }
 
+/*
+Equal returns a Boolean value indicative of whether the receiver and x
+describe the same OID, as determined by their numeric arcs alone. Valid
+input types for x are string (dot or braced ASN.1 notation), [DotNotation],
+[ASN1Notation] and [OID], as well as pointers to any of these types.
+
+A zero receiver or unparsable input guarantees a false return.
+*/
+func (r DotNotation) Equal(x any) bool {
+	return equalArcs(r, numericArcs(x))
+}
+
+/*
+numericArcs returns the numeric arcs of x, which can be any input type
+supported by [DotNotation.Equal]. A zero instance is returned if x is of
+an unsupported type or cannot be parsed.
+*/
+func numericArcs(x any) (d DotNotation) {
+	switch tv := x.(type) {
+	case string:
+		if D, err := NewDotNotation(tv); err == nil {
+			d = *D
+		} else if A, err := NewASN1Notation(tv); err == nil {
+			d = A.arcs()
+		}
+	case DotNotation:
+		d = tv
+	case *DotNotation:
+		if tv != nil {
+			d = *tv
+		}
+	case ASN1Notation:
+		d = tv.arcs()
+	case *ASN1Notation:
+		if tv != nil {
+			d = tv.arcs()
+		}
+	case OID:
+		d = tv.nanf.arcs()
+	case *OID:
+		if tv != nil {
+			d = tv.nanf.arcs()
+		}
+	}
+
+	return
+}
+
+/*
+equalArcs returns a Boolean value indicative of whether a and b are
+non-zero and bear identical numeric arcs.
+*/
+func equalArcs(a, b DotNotation) bool {
+	if a.Len() == 0 || a.Len() != b.Len() {
+		return false
+	}
+
+	for i := 0; i < a.Len(); i++ {
+		if a[i].cast().Cmp(b[i].cast()) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
 func assertDotNot(dot any) (D *DotNotation) {
 	switch tv := dot.(type) {
 	case string:
@@ -487,13 +1386,56 @@ func (r DotNotation) Valid() (is bool) {
 	return
 }
 
+/*
+Validate returns an error describing the first rule violated by the
+receiver, or nil if no violation is found. Rules are checked in the
+following order:
+
+  - Receiver's length must be greater than or equal to two (2) slice members
+  - No [NumberForm] slice member may be unset or negative
+  - The root [NumberForm] must be less than three (3)
+  - The second [NumberForm] must be less than forty (40), unless the root is joint-iso-itu-t(2)
+
+Note that an unset [NumberForm] cannot be distinguished from a zero (0)
+value, and is therefore only reported when negative.
+*/
+func (r DotNotation) Validate() (err error) {
+	if L := r.Len(); L < 2 {
+		err = errorf("%T requires two (2) or more arcs; found %d", r, L)
+		return
+	}
+
+	for i := 0; i < r.Len(); i++ {
+		if r[i].cast().Sign() < 0 {
+			err = errorf("%T arc %d is negative (%s)", r, i, r[i])
+			return
+		}
+	}
+
+	if r[0].cast().Cmp(big.NewInt(2)) > 0 {
+		err = errorf("%T root arc must be 0, 1 or 2; found %s", r, r[0])
+	} else if r[0].cast().Cmp(big.NewInt(2)) < 0 &&
+		r[1].cast().Cmp(big.NewInt(39)) > 0 {
+		err = errorf("%T second-level arc must be <= 39 below root %s; found %s",
+			r, r[0], r[1])
+	}
+
+	return
+}
+
 /*
 encodeVLQ returns the VLQ -- or Variable Length Quantity -- encoding of
-the raw input value.
+the raw input value. A zero value, including an empty b, is encoded as
+the single octet 0x00 rather than omitted, as each subidentifier must
+occupy at least one octet.
 */
 func encodeVLQ(b []byte) []byte {
-	var oid []byte
 	n := big.NewInt(0).SetBytes(b)
+	if n.Sign() == 0 {
+		return []byte{0x00}
+	}
+
+	var oid []byte
 
 	for n.Cmp(big.NewInt(0)) > 0 {
 		temp := new(big.Int)
@@ -507,51 +1449,3 @@ func encodeVLQ(b []byte) []byte {
 	}
 	return oid
 }
-
-func isNumericOID(id string) bool {
-	if !isValidOIDPrefix(id) {
-		return false
-	}
-
-	var last rune
-	for i, c := range id {
-		switch {
-		case c == '.':
-			if last == c {
-				return false
-			} else if i == len(id)-1 {
-				return false
-			}
-			last = '.'
-		case ('0' <= c && c <= '9'):
-			last = c
-			continue
-		}
-	}
-
-	return true
-}
-
-func isValidOIDPrefix(id string) bool {
-	slices := split(id, `.`)
-	if len(slices) < 2 {
-		return false
-	}
-
-	root, err := atoi(slices[0])
-	if err != nil {
-		return false
-	}
-	if !(0 <= root && root <= 2) {
-		return false
-	}
-
-	var sub int
-	if sub, err = atoi(slices[1]); err != nil {
-		return false
-	} else if !(0 <= sub && sub <= 39) && root != 2 {
-		return false
-	}
-
-	return true
-}
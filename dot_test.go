@@ -1,8 +1,14 @@
 package objectid
 
 import (
+	"bytes"
+	"encoding/asn1"
+	"encoding/gob"
+	"errors"
 	"fmt"
 	"math/big"
+	"sort"
+	"strconv"
 	"testing"
 )
 
@@ -363,7 +369,7 @@ func TestDotNotation_Codec(t *testing.T) {
 		`1.765`:   []byte(`bogus`),
 		`2.25`:    {0x06, 0x01, 0x69},
 		`2.-25`:   []byte(`bogus`),
-		`2.999`:   {0x06, 0x02, 0x87, 0x67},
+		`2.999`:   {0x06, 0x02, 0x88, 0x37},
 		`2.`:      []byte(`bogus`),
 		`1.3.6.1.4.1.56521.999`: {
 			0x06, 0x0a, 0x2b, 0x06, 0x01, 0x04,
@@ -404,3 +410,896 @@ func (r *DotNotation) encode2Decode(key string, slice []byte, t *testing.T) {
 		}
 	}
 }
+
+func TestDotNotation_Validate(t *testing.T) {
+	for idx, slice := range []DotNotation{
+		{},
+		{NumberForm(*big.NewInt(1))},
+		{NumberForm(*big.NewInt(3)), NumberForm(*big.NewInt(1))},
+		{NumberForm(*big.NewInt(1)), NumberForm(*big.NewInt(40))},
+		{NumberForm(*big.NewInt(1)), NumberForm(*big.NewInt(-3))},
+	} {
+		if err := slice.Validate(); err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nothing", t.Name(), idx)
+		}
+	}
+
+	for _, str := range []string{`1.3.6.1`, `2.999`, `0.39`} {
+		dot, _ := NewDotNotation(str)
+		if err := dot.Validate(); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		}
+	}
+}
+
+/*
+This example demonstrates use of the [DecodeNext] function, which
+consumes a single ASN.1 encoded OID from the front of a buffer and
+returns the remaining bytes for further processing.
+*/
+func ExampleDecodeNext() {
+	// pre-encoded bytes for OIDs 1.3.6.1 and 2.999
+	b := []byte{0x06, 0x03, 0x2b, 0x06, 0x01, 0x06, 0x02, 0x88, 0x37}
+
+	for len(b) > 0 {
+		var (
+			dot DotNotation
+			err error
+		)
+
+		if dot, b, err = DecodeNext(b); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(dot)
+	}
+	// Output:
+	// 1.3.6.1
+	// 2.999
+}
+
+func TestDecodeNext(t *testing.T) {
+	dot, _ := NewDotNotation(`2.25.987895962269883002155146617097157934`)
+	enc, _ := dot.Encode()
+	trailer := []byte{0x05, 0x00}
+
+	d, rest, err := DecodeNext(append(enc, trailer...))
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if d.String() != dot.String() {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), dot, d)
+	} else if string(rest) != string(trailer) {
+		t.Errorf("%s failed: unexpected remainder %v", t.Name(), rest)
+	}
+
+	// long-form length
+	if d, _, err = DecodeNext([]byte{0x06, 0x81, 0x03, 0x2b, 0x06, 0x01}); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if d.String() != `1.3.6.1` {
+		t.Errorf("%s failed: want '1.3.6.1', got '%s'", t.Name(), d)
+	}
+
+	for idx, bogus := range [][]byte{
+		nil,
+		{0x05, 0x01, 0x00},
+		{0x06, 0x05, 0x2b, 0x06},
+		{0x06, 0x80, 0x2b, 0x06, 0x00, 0x00},
+		{0x06, 0x85, 0x01, 0x01, 0x01, 0x01, 0x01},
+		{0x06, 0x82, 0x01},
+		{0x06, 0x02, 0x2b, 0x86},
+	} {
+		if _, _, err = DecodeNext(bogus); err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nothing", t.Name(), idx)
+		}
+	}
+}
+
+func TestNewDotNotation_prefixes(t *testing.T) {
+	for _, input := range []string{
+		`urn:oid:1.3.6.1.4.1.56521`,
+		`URN:OID:1.3.6.1.4.1.56521`,
+		`OID.1.3.6.1.4.1.56521`,
+		`oid.1.3.6.1.4.1.56521`,
+		`{1 3 6 1 4 1 56521}`,
+		`{ 1  3 6 1 4 1 56521 }`,
+		"{\n\t1 3 6 1\n\t4 1 56521\n}",
+		" { 1 3 6 1 4 1 56521 }\n",
+		`.1.3.6.1.4.1.56521`,
+		`1 3 6 1 4 1 56521`,
+		"1\t3 6  1 4 1 56521",
+	} {
+		dot, err := NewDotNotation(input)
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if got := dot.String(); got != `1.3.6.1.4.1.56521` {
+			t.Errorf("%s failed: want '1.3.6.1.4.1.56521', got '%s'", t.Name(), got)
+		}
+	}
+
+	if _, err := NewDotNotation(" urn:oid:1.3.6.1\n", AllowWhitespace()); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	}
+
+	for _, bogus := range []string{
+		`urn:oid:`,
+		`OID.`,
+		`{}`,
+		`{iso(1) 3 6}`,
+		`..1.3.6`,
+		`urn:oid:.1.3.6`,
+		`{1.3.6}`,
+		`{ 1 3 6 }}`,
+		`{ 3 1 }`,
+		`1 3 6 x`,
+		` 1 3 6`,
+		`1 3.6`,
+	} {
+		if _, err := NewDotNotation(bogus); err == nil {
+			t.Errorf("%s failed: bogus '%s' parsed without error", t.Name(), bogus)
+		}
+	}
+}
+
+func ExampleNewDotNotation_braced() {
+	dot, err := NewDotNotation(`{ 1 3 6 1 4 1 56521 }`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(dot)
+	// Output: 1.3.6.1.4.1.56521
+}
+
+func ExampleDotNotation_StringSep() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+
+	fmt.Println(dot.StringSep(` `))
+	fmt.Println(dot.StringSep(`, `))
+	// Output:
+	// 1 3 6 1 4 1 56521
+	// 1, 3, 6, 1, 4, 1, 56521
+}
+
+func TestDotNotation_StringSep(t *testing.T) {
+	dot := mustDot(`2.25.987895962269883002155146617097157934`)
+	for sep, want := range map[string]string{
+		`.`: dot.String(),
+		`/`: `2/25/987895962269883002155146617097157934`,
+		``:  `225987895962269883002155146617097157934`,
+	} {
+		if got := dot.StringSep(sep); got != want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		}
+	}
+
+	if s := (DotNotation{}).StringSep(` `); s != `` {
+		t.Errorf("%s failed: want zero string, got '%s'", t.Name(), s)
+	}
+
+	// Space-delimited output is accepted by NewDotNotation.
+	if back, err := NewDotNotation(dot.StringSep(` `)); err != nil || !back.Equal(dot) {
+		t.Errorf("%s failed: want %s, got %s (%v)", t.Name(), dot, back, err)
+	}
+}
+
+func ExampleDotNotation_StringLeadingDot() {
+	dot, err := NewDotNotation(`.1.3.6.1.2.1.1.3.0`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(dot, dot.StringLeadingDot())
+	// Output: 1.3.6.1.2.1.1.3.0 .1.3.6.1.2.1.1.3.0
+}
+
+func TestDotNotation_StringLeadingDot(t *testing.T) {
+	if s := (DotNotation{}).StringLeadingDot(); s != `` {
+		t.Errorf("%s failed: want zero string, got '%s'", t.Name(), s)
+	}
+
+	dot := mustDot(`2.25.987895962269883002155146617097157934`)
+	back, err := NewDotNotation(dot.StringLeadingDot())
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if !back.Equal(dot) {
+		t.Errorf("%s failed: want %s, got %s", t.Name(), dot, back)
+	}
+}
+
+func ExampleNewDotNotation_spaceDelimited() {
+	dot, err := NewDotNotation(`2 5 4 3`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(dot)
+	// Output: 2.5.4.3
+}
+
+func ExampleDotNotation_SetIndex() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521.999.1.5`)
+	if err := dot.SetIndex(-2, 2); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(dot)
+	// Output: 1.3.6.1.4.1.56521.999.2.5
+}
+
+func TestDotNotation_SetIndex(t *testing.T) {
+	dot, _ := NewDotNotation(`1.3.6.1`)
+	if err := dot.SetIndex(0, `2`); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if err = dot.SetIndex(-1, uint64(9)); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if dot.String() != `2.3.6.9` {
+		t.Errorf("%s failed: want '2.3.6.9', got '%s'", t.Name(), dot)
+	}
+
+	for idx, args := range [][]any{
+		{4, 1},
+		{-5, 1},
+		{0, 3},
+		{1, -1},
+		{1, `bogus`},
+	} {
+		if err := dot.SetIndex(args[0].(int), args[1]); err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nothing", t.Name(), idx)
+		}
+	}
+
+	if dot.String() != `2.3.6.9` {
+		t.Errorf("%s failed: receiver modified by failed operation: %s", t.Name(), dot)
+	}
+
+	var zero DotNotation
+	if err := zero.SetIndex(0, 1); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+}
+
+func ExampleDotNotation_Insert() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521.5`)
+	ins, err := dot.Insert(-1, 999)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(ins)
+	// Output: 1.3.6.1.4.1.56521.999.5
+}
+
+func ExampleDotNotation_Remove() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521.999.5`)
+	rem, err := dot.Remove(-2)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(rem)
+	// Output: 1.3.6.1.4.1.56521.5
+}
+
+func TestDotNotation_InsertRemove(t *testing.T) {
+	dot, _ := NewDotNotation(`1.3.6.1`)
+	for want, idx := range map[string]int{
+		`2.1.3.6.1`: 0,
+		`1.3.6.1.2`: 4,
+		`1.2.3.6.1`: 1,
+		`1.3.6.2.1`: -1,
+	} {
+		ins, err := dot.Insert(idx, 2)
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if ins.String() != want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, ins)
+		}
+	}
+
+	for want, idx := range map[string]int{
+		`3.6.1`: 0,
+		`1.3.6`: -1,
+		`1.6.1`: 1,
+	} {
+		rem, err := dot.Remove(idx)
+		if want == `3.6.1` {
+			if err == nil {
+				t.Errorf("%s failed: expected error, got nothing", t.Name())
+			}
+			continue
+		} else if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if rem.String() != want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, rem)
+		}
+	}
+
+	if _, err := dot.Insert(5, 1); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	} else if _, err = dot.Insert(0, 3); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	} else if _, err = dot.Insert(1, `x`); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	} else if _, err = dot.Remove(4); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+
+	if dot.String() != `1.3.6.1` {
+		t.Errorf("%s failed: receiver modified: %s", t.Name(), dot)
+	}
+}
+
+func ExampleDotNotation_FirstDifference() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521.999.5`)
+	fmt.Println(dot.FirstDifference(`1.3.6.1.4.1.56521.998.5`))
+	// Output: 7
+}
+
+func TestDotNotation_FirstDifference(t *testing.T) {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1`)
+	other, _ := NewDotNotation(`1.3.6.2`)
+	for input, want := range map[string]int{
+		`1.3.6.1.4.1`:       -1,
+		`1.3.6`:             -1,
+		`1.3.6.1.4.1.56521`: -1,
+		`1.3.6.2`:           3,
+		`2.3.6.1.4.1`:       0,
+		`1.2`:               1,
+		`bogus`:             0,
+	} {
+		if got := dot.FirstDifference(input); got != want {
+			t.Errorf("%s failed: %s: want %d, got %d", t.Name(), input, want, got)
+		}
+	}
+
+	if got := dot.FirstDifference(other); got != 3 {
+		t.Errorf("%s failed: want 3, got %d", t.Name(), got)
+	}
+}
+
+func ExampleDotNotation_Depth() {
+	dot, err := NewDotNotation(`1.3.6.1.4.1.56521`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%d %t %t", dot.Depth(), dot.IsRootArc(), dot.IsSecondLevel())
+	// Output: 7 false false
+}
+
+func TestDotNotation_Depth(t *testing.T) {
+	dot, _ := NewDotNotation(`2.999`)
+	if dot.Depth() != 2 || dot.IsRootArc() || !dot.IsSecondLevel() {
+		t.Errorf("%s failed: 2.999 misreported", t.Name())
+	}
+
+	if root := (*dot)[:1]; root.Depth() != 1 || !root.IsRootArc() || root.IsSecondLevel() {
+		t.Errorf("%s failed: root arc misreported", t.Name())
+	}
+
+	var zero DotNotation
+	if zero.Depth() != 0 || zero.IsRootArc() || zero.IsSecondLevel() {
+		t.Errorf("%s failed: zero instance misreported", t.Name())
+	}
+}
+
+func ExampleCheckEncoding() {
+	err := CheckEncoding([]byte{0x06, 0x03, 0x2B, 0x06, 0x01})
+	fmt.Println(err == nil)
+	// Output: true
+}
+
+func TestCheckEncoding(t *testing.T) {
+	for _, dot := range []string{`1.3.6.1.4.1.56521`, `2.999`, `0.0`, `2.25.987895962269883002155146617097157934`} {
+		d, _ := NewDotNotation(dot)
+		b, err := d.Encode()
+		if err != nil {
+			t.Fatalf("%s failed: %v", t.Name(), err)
+		} else if err = CheckEncoding(b); err != nil {
+			t.Errorf("%s failed for %s: %v", t.Name(), dot, err)
+		}
+	}
+
+	if err := CheckEncoding([]byte{0x82, 0x01, 0x2B}, WithImplicitTag(2)); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	}
+
+	for _, bogus := range [][]byte{
+		nil,
+		{0x06},
+		{0x04, 0x01, 0x2B},             // wrong tag
+		{0x06, 0x00},                   // zero length
+		{0x06, 0x02, 0x2B},             // short content
+		{0x06, 0x01, 0x2B, 0x06},       // trailing byte
+		{0x06, 0x02, 0x2B, 0x86},       // unterminated subidentifier
+		{0x06, 0x03, 0x2B, 0x80, 0x01}, // non-minimal subidentifier
+		{0x06, 0x02, 0x80, 0x01},       // non-minimal first subidentifier
+		{0x06, 0x80, 0x2B, 0x00, 0x00}, // indefinite length
+	} {
+		if err := CheckEncoding(bogus); err == nil {
+			t.Errorf("%s failed: bogus encoding %#v passed", t.Name(), bogus)
+		}
+	}
+
+	if err := CheckEncoding([]byte{0x06, 0x01, 0x2B}, WithTag(0x3F)); err == nil {
+		t.Errorf("%s failed: expected error for bogus option", t.Name())
+	}
+}
+
+func TestDotNotation_overflow(t *testing.T) {
+	dot, _ := NewDotNotation(`2.25.987895962269883002155146617097157934.1`)
+
+	ints, err := dot.IntSlice()
+	var oe *OverflowError
+	if !errors.As(err, &oe) {
+		t.Fatalf("%s failed: want %T, got %T", t.Name(), oe, err)
+	} else if oe.Index != 2 || !oe.Arc.Equal((*dot)[2]) || len(ints) != 2 {
+		t.Errorf("%s failed: bad overflow report %#v (%v)", t.Name(), oe, ints)
+	} else if !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("%s failed: underlying error not unwrapped", t.Name())
+	}
+
+	uints, err := dot.Uint64Slice()
+	if !errors.As(err, &oe) || oe.Index != 2 || len(uints) != 2 || uints[1] != 25 {
+		t.Errorf("%s failed: bad overflow report %v (%v)", t.Name(), err, uints)
+	}
+
+	u32, err := dot.Uint32Slice()
+	if !errors.As(err, &oe) || oe.Index != 2 || len(u32) != 2 || u32[1] != 25 {
+		t.Errorf("%s failed: bad overflow report %v (%v)", t.Name(), err, u32)
+	}
+
+	max, _ := NewDotNotation(`2.25.4294967295`)
+	if u32, err = max.Uint32Slice(); err != nil || u32[2] != 1<<32-1 {
+		t.Errorf("%s failed: unexpected result %v (%v)", t.Name(), u32, err)
+	}
+}
+
+func ExampleDotNotation_AncestryFunc() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	dot.AncestryFunc(func(anc DotNotation) bool {
+		fmt.Println(anc)
+		return anc.Len() > 6 // stop at 1.3.6.1.4.1
+	})
+	// Output:
+	// 1.3.6.1.4.1.56521
+	// 1.3.6.1.4.1
+}
+
+func TestDotNotation_AncestryFunc(t *testing.T) {
+	dot, _ := NewDotNotation(`2.999.1.2.3`)
+	want := dot.Ancestry()
+
+	var got []DotNotation
+	dot.AncestryFunc(func(anc DotNotation) bool {
+		got = append(got, anc)
+		return true
+	})
+
+	if len(got) != len(want) {
+		t.Fatalf("%s failed: want %d ancestors, got %d", t.Name(), len(want), len(got))
+	}
+	for i := 0; i < len(got); i++ {
+		if got[i].String() != want[i].String() {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want[i], got[i])
+		}
+	}
+}
+
+func ExampleDotNotation_NextSibling() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521.999.5`)
+	next, err := dot.NextSibling()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(next)
+	// Output: 1.3.6.1.4.1.56521.999.6
+}
+
+func TestDotNotation_siblings(t *testing.T) {
+	dot, _ := NewDotNotation(`2.25.340282366920938463463374607431768211455`)
+	if next, err := dot.NextSibling(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if want := `2.25.340282366920938463463374607431768211456`; next.String() != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, next)
+	} else if prev, err := next.PreviousSibling(); err != nil || prev.String() != dot.String() {
+		t.Errorf("%s failed: round trip failed: %v", t.Name(), err)
+	} else if dot.String() != `2.25.340282366920938463463374607431768211455` {
+		t.Errorf("%s failed: receiver modified", t.Name())
+	}
+
+	for _, tc := range []struct {
+		dot  string
+		next bool
+	}{
+		{`1.3.0`, false}, // no previous sibling
+		{`1.39`, true},   // 1.40 is invalid
+		{`1.0`, false},
+	} {
+		d, _ := NewDotNotation(tc.dot)
+		var err error
+		if tc.next {
+			_, err = d.NextSibling()
+		} else {
+			_, err = d.PreviousSibling()
+		}
+		if err == nil {
+			t.Errorf("%s failed: expected error for %s", t.Name(), tc.dot)
+		}
+	}
+
+	var zero DotNotation
+	if _, err := zero.NextSibling(); err == nil {
+		t.Errorf("%s failed: expected error for zero instance", t.Name())
+	}
+}
+
+func TestNewDotNotation_strict(t *testing.T) {
+	for _, bogus := range []string{
+		`1.3.6x`,
+		`1.3.6.`,
+		`1..3`,
+		`1.3.-6`,
+		`1.3.6.1.4.1.56521.999.5é`,
+		`3.1`,
+		`1.40`,
+		`1`,
+	} {
+		if _, err := NewDotNotation(bogus); err == nil {
+			t.Errorf("%s failed: bogus OID '%s' parsed without error", t.Name(), bogus)
+		}
+	}
+
+	for _, valid := range []string{
+		`2.340282366920938463463374607431768211456.1`,
+		`2.25.18446744073709551615`,
+		`2.25.18446744073709551616`,
+		`0.0`,
+	} {
+		if dot, err := NewDotNotation(valid); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if dot.String() != valid {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), valid, dot)
+		}
+	}
+}
+
+func BenchmarkNewDotNotation(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = NewDotNotation(`1.3.6.1.4.1.56521.999.5.1.2`)
+	}
+}
+
+func BenchmarkNewDotNotation_uuid(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = NewDotNotation(`2.25.987895962269883002155146617097157934`)
+	}
+}
+
+func TestParseArcKey(t *testing.T) {
+	// parseArcKey shares the scanner of NewDotNotation, whose errors
+	// report the position of any violation.
+	for id, want := range map[string]string{
+		`1.3.6x`:                    `Invalid OID '1.3.6x': disallowed character at position 5`,
+		`1.3.6.1 `:                  `Invalid OID '1.3.6.1 ': disallowed character at position 7`,
+		`1.3é`:                      `Invalid OID '1.3é': disallowed character at position 3`,
+		`1..3`:                      `Invalid OID '1..3': empty arc at position 2`,
+		`1.3.`:                      `Invalid OID '1.3.': empty arc at position 4`,
+		`.1.3`:                      `Invalid OID '.1.3': empty arc at position 0`,
+		`3.1`:                       `objectid.DotNotation root arc must be 0, 1 or 2; found 3`,
+		`1.40`:                      `objectid.DotNotation second-level arc must be <= 39 below root 1; found 40`,
+		`0.100`:                     `objectid.DotNotation second-level arc must be <= 39 below root 0; found 100`,
+		`1.39.5`:                    ``,
+		`01.039`:                    ``,
+		`2.99999999999999999999999`: ``,
+	} {
+		_, err := parseDotString(id, newParseConfig())
+		if _, got := parseArcKey(id); got != (want == ``) {
+			t.Errorf("%s failed for '%s': want %t, got %t", t.Name(), id, want == ``, got)
+		} else if want == `` && err != nil {
+			t.Errorf("%s failed for '%s': unexpected error: %v", t.Name(), id, err)
+		} else if want != `` && fmt.Sprint(err) != want {
+			t.Errorf("%s failed for '%s':\n\twant: %s\n\tgot:  %v", t.Name(), id, want, err)
+		}
+	}
+
+	// Root arcs alone are permitted as keys.
+	for key, want := range map[string]bool{`0`: true, `2`: true, `3`: false, ``: false} {
+		if d, got := parseArcKey(key); got != want || got && d.String() != key {
+			t.Errorf("%s failed for '%s': want %t, got %t (%s)", t.Name(), key, want, got, d)
+		}
+	}
+}
+
+func ExampleDotNotation_HasPrefix() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521.999.5`)
+	fmt.Println(dot.HasPrefix(`1.3.6.1.4.1`))
+	// Output: true
+}
+
+func TestDotNotation_HasPrefix(t *testing.T) {
+	dot, _ := NewDotNotation(`2.25.987895962269883002155146617097157934.1`)
+	pfx, _ := NewDotNotation(`2.25`)
+
+	for i, tc := range []struct {
+		prefix any
+		want   bool
+	}{
+		{`2`, true},
+		{`2.25`, true},
+		{`2.25.987895962269883002155146617097157934`, true},
+		{`2.25.987895962269883002155146617097157934.1`, true},
+		{`2.25.987895962269883002155146617097157935`, false},
+		{`2.25.987895962269883002155146617097157934.1.1`, false},
+		{`2.2`, false},
+		{`2.25.`, false},
+		{`.2.25`, false},
+		{`2..25`, false},
+		{`2.-25`, false},
+		{`2.x`, false},
+		{``, false},
+		{pfx, true},
+		{*pfx, true},
+		{(*DotNotation)(nil), false},
+		{DotNotation{}, false},
+		{25, false},
+	} {
+		if got := dot.HasPrefix(tc.prefix); got != tc.want {
+			t.Errorf("%s[%d] failed: want %t, got %t", t.Name(), i, tc.want, got)
+		}
+	}
+
+	if (DotNotation{}).HasPrefix(`2`) {
+		t.Errorf("%s failed: zero instance matched prefix", t.Name())
+	}
+
+	if n := testing.AllocsPerRun(100, func() { _ = dot.HasPrefix(`2.25`) }); n > 0 {
+		t.Errorf("%s failed: want zero allocations, got %.0f", t.Name(), n)
+	}
+}
+
+func ExampleDotNotation_Equal() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	fmt.Println(dot.Equal(`{iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) 56521}`))
+	// Output: true
+}
+
+func TestDotNotation_Equal(t *testing.T) {
+	dot, _ := NewDotNotation(`1.3.6.1`)
+	asn, _ := NewASN1Notation(`{iso(1) org(3) dod(6) internet(1)}`)
+	oid, _ := NewOID(`{iso(1) identified-organization(3) dod(6) internet(1)}`)
+
+	for i, tc := range []struct {
+		x    any
+		want bool
+	}{
+		{`1.3.6.1`, true},
+		{`{1 3 6 1}`, true},
+		{`{iso(1) identified-organization(3) dod(6) internet(1)}`, true},
+		{*dot, true},
+		{dot, true},
+		{*asn, true},
+		{asn, true},
+		{*oid, true},
+		{oid, true},
+		{`1.3.6`, false},
+		{`1.3.6.1.1`, false},
+		{`1.3.6.2`, false},
+		{`bogus`, false},
+		{(*DotNotation)(nil), false},
+		{(*ASN1Notation)(nil), false},
+		{(*OID)(nil), false},
+		{1, false},
+	} {
+		if got := dot.Equal(tc.x); got != tc.want {
+			t.Errorf("%s[%d] failed: want %t, got %t", t.Name(), i, tc.want, got)
+		}
+		if got := asn.Equal(tc.x); got != tc.want {
+			t.Errorf("%s[%d] failed: ASN1Notation want %t, got %t", t.Name(), i, tc.want, got)
+		}
+		if got := oid.Equal(tc.x); got != tc.want {
+			t.Errorf("%s[%d] failed: OID want %t, got %t", t.Name(), i, tc.want, got)
+		}
+	}
+
+	if (DotNotation{}).Equal(DotNotation{}) {
+		t.Errorf("%s failed: zero instances considered equal", t.Name())
+	}
+}
+
+func TestDotNotation_Encode_noMutation(t *testing.T) {
+	for _, raw := range []string{
+		`2.25.987895962269883002155146617097157934`,
+		`1.3.6.1.4.1.56521`,
+		`2.999.1`,
+		`0.0`,
+	} {
+		dot, _ := NewDotNotation(raw)
+		want, err := dot.Encode()
+		if err != nil {
+			t.Fatalf("%s failed: %v", t.Name(), err)
+		}
+
+		for i := 0; i < 3; i++ {
+			got, _ := dot.Encode()
+			if dot.String() != raw || string(got) != string(want) {
+				t.Errorf("%s failed: %s altered to %s after encoding", t.Name(), raw, dot)
+			}
+		}
+	}
+}
+
+func ExampleDotNotation_AppendText() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	buf, _ := dot.AppendText([]byte(`oid=`))
+	fmt.Println(string(buf))
+	// Output: oid=1.3.6.1.4.1.56521
+}
+
+func TestDotNotation_Appenders(t *testing.T) {
+	// local equivalents of encoding.TextAppender and
+	// encoding.BinaryAppender, which postdate go.mod.
+	type textAppender interface {
+		AppendText([]byte) ([]byte, error)
+	}
+	type binaryAppender interface {
+		AppendBinary([]byte) ([]byte, error)
+	}
+	var _ textAppender = DotNotation{}
+	var _ binaryAppender = DotNotation{}
+
+	raw := `2.25.987895962269883002155146617097157934`
+	dot, _ := NewDotNotation(raw)
+	if b, err := dot.AppendText(nil); err != nil || string(b) != raw {
+		t.Errorf("%s failed: want '%s', got '%s' (%v)", t.Name(), raw, b, err)
+	}
+
+	want, _ := dot.Encode()
+	if b, err := dot.AppendBinary([]byte{0xff}); err != nil || string(b) != string(append([]byte{0xff}, want...)) {
+		t.Errorf("%s failed: bad binary append %#v (%v)", t.Name(), b, err)
+	}
+
+	pre := []byte(`x`)
+	if b, err := (DotNotation{}).AppendText(pre); err == nil || string(b) != `x` {
+		t.Errorf("%s failed: zero instance appended without error", t.Name())
+	}
+	if b, err := (DotNotation{}).AppendBinary(pre); err == nil || string(b) != `x` {
+		t.Errorf("%s failed: zero instance appended without error", t.Name())
+	}
+}
+
+func ExampleLessDER() {
+	var dots []DotNotation
+	for _, raw := range []string{`2.999`, `1.3.6.1.4.1`, `1.3.6.1`, `1.2.840`} {
+		dot, _ := NewDotNotation(raw)
+		dots = append(dots, *dot)
+	}
+
+	sort.Slice(dots, func(i, j int) bool { return LessDER(dots[i], dots[j]) })
+	fmt.Println(dots)
+	// Output: [2.999 1.2.840 1.3.6.1 1.3.6.1.4.1]
+}
+
+func TestLessDER(t *testing.T) {
+	for i, tc := range []struct {
+		a, b string
+		want bool
+	}{
+		{`1.3.6.1`, `1.3.6.1.4`, true},  // shorter length octet
+		{`1.3.6.1.4`, `1.3.6.1`, false}, // longer length octet
+		{`1.2.840`, `1.3.6`, false},     // length octet precedes content
+		{`1.2.840`, `1.3.6.1`, true},
+		{`1.3.6.1`, `1.3.6.1`, false},
+		{`2.999`, `1.3.6.1.4.1.56521`, true},
+		{`1.1`, `2.41`, true},
+		{`2.41`, `1.1`, false},
+		{`1.3`, `bogus`, true},
+		{`bogus`, `1.3`, false},
+		{`bogus`, `bogus`, false},
+		{`1.3.6`, `1.3.6.0`, true}, // trailing zero arc occupies an octet
+		{`1.3.6.0`, `1.3.6`, false},
+		{`1.3.6.0`, `1.3.6.0`, false},
+		{`1.3.0.6`, `1.3.6.0`, true},
+		{`2.25.0`, `2.25.0.0`, true},
+	} {
+		var a, b DotNotation
+		if d, err := NewDotNotation(tc.a); err == nil {
+			a = *d
+		}
+		if d, err := NewDotNotation(tc.b); err == nil {
+			b = *d
+		}
+
+		if got := LessDER(a, b); got != tc.want {
+			t.Errorf("%s[%d] failed: want %t, got %t", t.Name(), i, tc.want, got)
+		}
+	}
+
+	// The ordering must agree with that of the encodings produced by
+	// encoding/asn1, compared as zero-padded octet strings.
+	oids := []string{`0.0`, `1.1`, `1.39`, `2.39`, `2.40`, `2.41`, `2.48`, `2.100`, `2.999`, `2.999.3`, `1.3.6.1`, `1.3.6`, `1.3.6.0`, `1.3.0.6`, `2.25.0`, `2.25.0.0`}
+	for _, x := range oids {
+		for _, y := range oids {
+			ex, _ := mustDot(x).IntSlice()
+			ey, _ := mustDot(y).IntSlice()
+			bx, _ := asn1.Marshal(asn1.ObjectIdentifier(ex))
+			by, _ := asn1.Marshal(asn1.ObjectIdentifier(ey))
+			for len(bx) < len(by) {
+				bx = append(bx, 0x00)
+			}
+			for len(by) < len(bx) {
+				by = append(by, 0x00)
+			}
+
+			if want, got := bytes.Compare(bx, by) < 0, LessDER(mustDot(x), mustDot(y)); got != want {
+				t.Errorf("%s failed for %s < %s: want %t, got %t", t.Name(), x, y, want, got)
+			}
+		}
+	}
+}
+
+func ExampleDotNotation_MarshalBinary() {
+	dot := mustDot(`1.3.6.1.4.1.56521`)
+	b, _ := dot.MarshalBinary()
+	fmt.Printf("%#x", b)
+	// Output: 0x06082b0601040183b949
+}
+
+func TestDotNotation_MarshalBinary(t *testing.T) {
+	type record struct {
+		Name string
+		Dot  DotNotation
+	}
+
+	want := record{Name: `uuid`, Dot: mustDot(`2.25.987895962269883002155146617097157934`)}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	var got record
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if got.Name != want.Name || got.Dot.String() != want.Dot.String() {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), want, got)
+	}
+
+	// Second-level arcs of joint-iso-itu-t(2) above 39 must share the
+	// first subidentifier with the root, as with encoding/asn1.
+	for _, s := range []string{`2.39`, `2.40`, `2.41`, `2.48`, `2.100`, `2.999.3`, `0.0`, `1.39.1`, `1.3.6.1.2.1.1.3.0`, `2.25.0`, `1.3.0.6`, `2.0.0.0`} {
+		d := mustDot(s)
+		ints, _ := d.IntSlice()
+		want, _ := asn1.Marshal(asn1.ObjectIdentifier(ints))
+
+		var got DotNotation
+		if b, err := d.MarshalBinary(); err != nil {
+			t.Errorf("%s failed for %s: %v", t.Name(), s, err)
+		} else if !bytes.Equal(b, want) {
+			t.Errorf("%s failed for %s: want % x, got % x", t.Name(), s, want, b)
+		} else if err = got.UnmarshalBinary(want); err != nil {
+			t.Errorf("%s failed for %s: %v", t.Name(), s, err)
+		} else if got.String() != s {
+			t.Errorf("%s failed: want %s, got %s", t.Name(), s, got)
+		}
+	}
+
+	var dot DotNotation
+	if err := dot.UnmarshalBinary([]byte{0x06, 0x01}); err == nil {
+		t.Errorf("%s failed: expected error for truncated encoding", t.Name())
+	} else if _, err = (DotNotation{}).MarshalBinary(); err == nil {
+		t.Errorf("%s failed: expected error for zero value", t.Name())
+	} else if _, err = (DotNotation{NumberForm(*big.NewInt(3)), NumberForm(*big.NewInt(1))}).MarshalBinary(); err == nil {
+		t.Errorf("%s failed: expected error for root arc 3", t.Name())
+	}
+}
@@ -0,0 +1,170 @@
+package objectid
+
+/*
+explain.go implements the per-arc explanation of DotNotation values, and
+the reference table which supports it.
+*/
+
+import "sync"
+
+/*
+arcReferences contains the standard references defining well-known
+arcs, keyed by the dot notation of the arc.
+*/
+var arcReferences = struct {
+	sync.RWMutex
+	byArc map[string]string
+}{
+	byArc: map[string]string{
+		`0`:                  `Rec. ITU-T X.660 | ISO/IEC 9834-1`,
+		`1`:                  `Rec. ITU-T X.660 | ISO/IEC 9834-1`,
+		`1.2.840.10045`:      `ANSI X9.62`,
+		`1.2.840.113549.1.1`: `RFC 8017`,
+		`1.2.840.113549.1.9`: `RFC 2985`,
+		`1.3.6`:              `RFC 1155`,
+		`1.3.6.1`:            `RFC 1155`,
+		`1.3.6.1.2.1`:        `RFC 1213`,
+		`1.3.6.1.4.1`:        `RFC 1155`,
+		`1.3.6.1.5.5.7`:      `RFC 5280`,
+		`1.3.6.1.6`:          `RFC 2578`,
+		`2`:                  `Rec. ITU-T X.660 | ISO/IEC 9834-1`,
+		`2.1`:                `Rec. ITU-T X.680 | ISO/IEC 8824-1`,
+		`2.5`:                `Rec. ITU-T X.501 | ISO/IEC 9594-2`,
+		`2.5.4`:              `Rec. ITU-T X.520 | ISO/IEC 9594-6`,
+		`2.5.6`:              `Rec. ITU-T X.521 | ISO/IEC 9594-7`,
+		`2.5.29`:             `Rec. ITU-T X.509 | ISO/IEC 9594-8`,
+		`2.25`:               `Rec. ITU-T X.667 | ISO/IEC 9834-8`,
+		`2.27`:               `Rec. ITU-T X.668 | ISO/IEC 9834-9`,
+		`2.999`:              `Rec. ITU-T X.660 | ISO/IEC 9834-1`,
+	},
+}
+
+/*
+RegisterReference assigns the standard reference ref (e.g.: "RFC 5280")
+to the arc identified by dot, which can be a string or [DotNotation],
+within the package-wide reference table. Any reference previously
+assigned to the arc is replaced.
+
+An error is returned if dot is invalid or if ref is zero length.
+*/
+func RegisterReference(dot any, ref string) (err error) {
+	key, ok := arcKey(dot)
+	if !ok {
+		err = errorf("Invalid arc for reference registration: %v", dot)
+		return
+	} else if ref = trimS(ref); len(ref) == 0 {
+		err = errorf("Zero length reference for %s", key)
+		return
+	}
+
+	arcReferences.Lock()
+	defer arcReferences.Unlock()
+	arcReferences.byArc[key] = ref
+
+	return
+}
+
+/*
+LookupReference returns the standard reference assigned to the arc
+identified by dot, which can be a string or [DotNotation], alongside a
+Boolean value indicative of a successful lookup.
+*/
+func LookupReference(dot any) (ref string, found bool) {
+	if key, ok := arcKey(dot); ok {
+		arcReferences.RLock()
+		defer arcReferences.RUnlock()
+		ref, found = arcReferences.byArc[key]
+	}
+
+	return
+}
+
+/*
+ArcExplanation describes a single arc within an [Explanation].
+*/
+type ArcExplanation struct {
+	// Dot contains the complete DotNotation through this arc.
+	Dot DotNotation
+
+	// Number contains the NumberForm of this arc.
+	Number NumberForm
+
+	// Identifier contains the ASN.1 identifier of this arc, as
+	// assigned by RegisterIdentifier, if known.
+	Identifier string
+
+	// Name contains the object name of this arc, as assigned by
+	// RegisterObjectName, if known.
+	Name ObjectName
+
+	// Reference contains the standard reference which defines this
+	// arc, as assigned by RegisterReference, if known.
+	Reference string
+}
+
+/*
+Explanation is a per-arc breakdown of a [DotNotation], as returned by the
+[DotNotation.Explain] method. Each slice member describes the arc at the
+corresponding index.
+*/
+type Explanation []ArcExplanation
+
+/*
+Explain returns an [Explanation] of the receiver, in which each arc is
+annotated with its number, known ASN.1 identifier and object name, and
+the standard reference which defines it, where available. This is useful
+for "describe this OID" features, as found in command-line tooling.
+*/
+func (r DotNotation) Explain() (e Explanation) {
+	if r.Len() == 0 {
+		return
+	}
+
+	e = make(Explanation, r.Len())
+	key := make([]byte, 0, 8*r.Len())
+
+	arcReferences.RLock()
+	defer arcReferences.RUnlock()
+
+	for i := 0; i < r.Len(); i++ {
+		if i > 0 {
+			key = append(key, '.')
+		}
+		key = r[i].AppendDecimal(key)
+		k := string(key)
+
+		e[i].Dot = r[: i+1 : i+1]
+		e[i].Number = r[i]
+		e[i].Identifier, _ = lookupIdentifier(k)
+		e[i].Name, _ = LookupObjectName(k)
+		e[i].Reference = arcReferences.byArc[k]
+	}
+
+	return
+}
+
+/*
+String returns a human-readable, multi-line rendering of the receiver,
+bearing one line per arc in the form "<dot> <identifier> (<long name>)
+[<reference>]", in which unknown elements are omitted.
+*/
+func (r Explanation) String() string {
+	var b []byte
+	for i := 0; i < len(r); i++ {
+		if i > 0 {
+			b = append(b, '\n')
+		}
+		b, _ = r[i].Dot.AppendText(b)
+		if id := r[i].Identifier; len(id) > 0 {
+			b = append(append(b, ' '), id...)
+		}
+		if ln := r[i].Name.Long; len(ln) > 0 && ln != r[i].Identifier {
+			b = append(append(append(b, " ("...), ln...), ')')
+		}
+		if ref := r[i].Reference; len(ref) > 0 {
+			b = append(append(append(b, " ["...), ref...), ']')
+		}
+	}
+
+	return string(b)
+}
@@ -0,0 +1,76 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleDotNotation_Explain() {
+	dot, err := NewDotNotation(`2.5.29.19`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(dot.Explain())
+	// Output:
+	// 2 joint-iso-itu-t [Rec. ITU-T X.660 | ISO/IEC 9834-1]
+	// 2.5 ds [Rec. ITU-T X.501 | ISO/IEC 9594-2]
+	// 2.5.29 certificateExtension [Rec. ITU-T X.509 | ISO/IEC 9594-8]
+	// 2.5.29.19 (X509v3 Basic Constraints)
+}
+
+func TestDotNotation_Explain(t *testing.T) {
+	dot := mustDot(`1.3.6.1.4.1.56521`)
+	e := dot.Explain()
+	if len(e) != dot.Len() {
+		t.Fatalf("%s failed: want %d arcs, got %d", t.Name(), dot.Len(), len(e))
+	}
+
+	for i, want := range []struct {
+		dot, id, ref string
+	}{
+		{`1`, `iso`, `Rec. ITU-T X.660 | ISO/IEC 9834-1`},
+		{`1.3`, `identified-organization`, ``},
+		{`1.3.6`, `dod`, `RFC 1155`},
+		{`1.3.6.1`, `internet`, `RFC 1155`},
+		{`1.3.6.1.4`, `private`, ``},
+		{`1.3.6.1.4.1`, `enterprise`, `RFC 1155`},
+		{`1.3.6.1.4.1.56521`, ``, ``},
+	} {
+		if got := e[i].Dot.String(); got != want.dot {
+			t.Errorf("%s failed at arc %d: want dot %s, got %s", t.Name(), i, want.dot, got)
+		} else if e[i].Number.String() != dot[i].String() {
+			t.Errorf("%s failed at arc %d: want number %s, got %s", t.Name(), i, dot[i], e[i].Number)
+		} else if e[i].Identifier != want.id {
+			t.Errorf("%s failed at arc %d: want identifier '%s', got '%s'", t.Name(), i, want.id, e[i].Identifier)
+		} else if e[i].Reference != want.ref {
+			t.Errorf("%s failed at arc %d: want reference '%s', got '%s'", t.Name(), i, want.ref, e[i].Reference)
+		}
+	}
+
+	if err := RegisterReference(`1.3.6.1.4.1.56521`, ` https://oid.example/56521 `); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+	defer func() {
+		arcReferences.Lock()
+		delete(arcReferences.byArc, `1.3.6.1.4.1.56521`)
+		arcReferences.Unlock()
+	}()
+
+	if ref, found := LookupReference(`1.3.6.1.4.1.56521`); !found || ref != `https://oid.example/56521` {
+		t.Errorf("%s failed: unexpected reference '%s' (found: %t)", t.Name(), ref, found)
+	} else if got := dot.Explain()[6].Reference; got != ref {
+		t.Errorf("%s failed: want reference '%s', got '%s'", t.Name(), ref, got)
+	}
+
+	if err := RegisterReference(`bogus`, `RFC 1`); err == nil {
+		t.Errorf("%s failed: expected error for invalid arc", t.Name())
+	} else if err = RegisterReference(`2.999`, ` `); err == nil {
+		t.Errorf("%s failed: expected error for zero length reference", t.Name())
+	}
+
+	if e = (DotNotation{}).Explain(); e != nil {
+		t.Errorf("%s failed: expected nil explanation for zero value, got %v", t.Name(), e)
+	}
+}
@@ -0,0 +1,77 @@
+package objectid
+
+/*
+format.go contains alternative string renderings of DotNotation values
+and of ancestry slices.
+*/
+
+/*
+FormatStyle describes an output spelling for use with the
+[DotNotation.Format] method.
+*/
+type FormatStyle uint8
+
+const (
+	FormatDotted     FormatStyle = iota // 1.3.6.1 (default)
+	FormatBraced                        // {1 3 6 1}
+	FormatURN                           // urn:oid:1.3.6.1 (RFC 3061)
+	FormatLeadingDot                    // .1.3.6.1 (as used by SNMP tooling)
+	FormatIRI                           // /ISO/Identified-Organization/6/1
+)
+
+/*
+Format returns the string representation of the receiver in the spelling
+described by style. The [FormatIRI] style renders each arc by its primary
+Unicode label, where registered, as with the [DotNotation.IRI] method.
+
+A zero string is returned if the receiver is zero length. An unrecognized
+style results in the [FormatDotted] spelling.
+*/
+func (r DotNotation) Format(style FormatStyle) (s string) {
+	if r.Len() == 0 {
+		return
+	}
+
+	switch style {
+	case FormatBraced:
+		arcs := make([]string, r.Len())
+		for i := 0; i < r.Len(); i++ {
+			arcs[i] = r[i].String()
+		}
+		s = `{` + join(arcs, ` `) + `}`
+	case FormatURN:
+		s = `urn:oid:` + r.String()
+	case FormatLeadingDot:
+		s = `.` + r.String()
+	case FormatIRI:
+		s = r.IRI().String()
+	default:
+		s = r.String()
+	}
+
+	return
+}
+
+/*
+FormatAncestry returns the string representation of anc, which must be
+the output of the [DotNotation.Ancestry] or [ASN1Notation.Ancestry]
+methods, with each ancestor joined by sep (e.g.: " -> " or "\n").
+
+A zero string is returned if anc is empty or of an unsupported type.
+*/
+func FormatAncestry(anc any, sep string) (s string) {
+	var x []string
+	switch tv := anc.(type) {
+	case []DotNotation:
+		for i := 0; i < len(tv); i++ {
+			x = append(x, tv[i].String())
+		}
+	case []ASN1Notation:
+		for i := 0; i < len(tv); i++ {
+			x = append(x, tv[i].String())
+		}
+	}
+
+	s = join(x, sep)
+	return
+}
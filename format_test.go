@@ -0,0 +1,73 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleDotNotation_Format() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	for _, style := range []FormatStyle{
+		FormatDotted,
+		FormatBraced,
+		FormatURN,
+		FormatLeadingDot,
+		FormatIRI,
+	} {
+		fmt.Println(dot.Format(style))
+	}
+	// Output:
+	// 1.3.6.1.4.1.56521
+	// {1 3 6 1 4 1 56521}
+	// urn:oid:1.3.6.1.4.1.56521
+	// .1.3.6.1.4.1.56521
+	// /ISO/Identified-Organization/6/1/4/1/56521
+}
+
+func TestDotNotation_Format(t *testing.T) {
+	dot, _ := NewDotNotation(`2.999.7`)
+	for style, want := range map[FormatStyle]string{
+		FormatDotted:     `2.999.7`,
+		FormatBraced:     `{2 999 7}`,
+		FormatURN:        `urn:oid:2.999.7`,
+		FormatLeadingDot: `.2.999.7`,
+		FormatIRI:        `/Joint-ISO-ITU-T/Example/7`,
+		FormatStyle(99):  `2.999.7`,
+	} {
+		if got := dot.Format(style); got != want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		}
+
+		// All styles but IRI must be accepted by NewDotNotation.
+		if style == FormatIRI {
+			continue
+		} else if rt, err := NewDotNotation(dot.Format(style)); err != nil || rt.String() != dot.String() {
+			t.Errorf("%s failed: style %d did not round trip: %v", t.Name(), style, err)
+		}
+	}
+
+	var zero DotNotation
+	if got := zero.Format(FormatURN); got != `` {
+		t.Errorf("%s failed: zero instance yielded '%s'", t.Name(), got)
+	}
+}
+
+func ExampleFormatAncestry() {
+	dot, _ := NewDotNotation(`1.3.6.1`)
+	fmt.Println(FormatAncestry(dot.Ancestry(), ` -> `))
+	// Output: 1.3.6.1 -> 1.3.6 -> 1.3 -> 1
+}
+
+func TestFormatAncestry(t *testing.T) {
+	asn, _ := NewASN1Notation(`{iso(1) identified-organization(3) dod(6)}`)
+	want := "{iso(1) identified-organization(3) dod(6)}\n{iso(1) identified-organization(3)}\n{iso(1)}"
+	if got := FormatAncestry(asn.Ancestry(), "\n"); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+	}
+
+	for _, bogus := range []any{nil, []DotNotation{}, `1.3.6`} {
+		if got := FormatAncestry(bogus, `,`); got != `` {
+			t.Errorf("%s failed: want zero string, got '%s'", t.Name(), got)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package objectid
+
+/*
+gosrc.go implements Go source code generation from Registry contents.
+*/
+
+import (
+	"bytes"
+	"go/format"
+	"go/token"
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+goImportPath is the import path of this package, as referenced by source
+code produced by [Registry.ExportGo].
+*/
+const goImportPath = `github.com/oid-directory/go-objectid`
+
+/*
+ExportGo writes a gofmt-formatted Go source file belonging to package pkg
+to w, declaring a *[DotNotation] variable for each record within the
+receiver that resides at or beneath subtree, returning an error if the
+operation fails. For example:
+
+	// OIDExample is 2.999 (example).
+	OIDExample = objectid.MustNewDotNotation(`2.999`)
+
+The subtree is interpreted in the manner of [Registry.Subtree]. Root arcs
+are skipped, as they do not constitute a valid [DotNotation]. Should no
+record remain, the source bears only its package clause.
+
+Variable names are derived from the Identifier of each record by removing
+hyphens and capitalizing each word (e.g.: "id-kp-serverAuth" becomes
+"OIDIdKpServerAuth"). Records lacking an Identifier, or whose name would
+collide with that of a preceding record, are named using their numeric
+form (e.g.: "OID_2_999"). The Description, if any, is used as the doc
+comment.
+*/
+func (r *Registry) ExportGo(w io.Writer, subtree any, pkg string) (err error) {
+	if !token.IsIdentifier(pkg) {
+		err = errorf("Invalid Go package name '%s'", pkg)
+		return
+	}
+
+	var sub RegistrySubtree
+	if sub, err = r.exportSubtree(subtree, `Go`); err != nil {
+		return
+	}
+
+	var decls bytes.Buffer
+	names := make(map[string]bool)
+	recs := sub.Records()
+	for i := 0; i < len(recs); i++ {
+		rec := recs[i]
+		if rec.Dot.Len() < 2 {
+			continue
+		}
+
+		if len(names) > 0 {
+			decls.WriteByte('\n')
+		}
+
+		name := goVarName(rec.Identifier)
+		if len(name) == 0 || names[name] {
+			name = `OID_` + join(split(rec.Dot.String(), `.`), `_`)
+		}
+		names[name] = true
+
+		decls.WriteString(rec.goDecl(name))
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by go-objectid. DO NOT EDIT.\n\n")
+	buf.WriteString("package " + pkg + "\n")
+	if len(names) > 0 {
+		// The import is only referenced by the declarations,
+		// and so is omitted along with them, lest the source
+		// fail to compile.
+		buf.WriteString("\nimport \"" + goImportPath + "\"\n\n")
+		buf.WriteString("var (\n")
+		buf.Write(decls.Bytes())
+		buf.WriteString(")\n")
+	}
+
+	var src []byte
+	if src, err = format.Source(buf.Bytes()); err == nil {
+		_, err = w.Write(src)
+	}
+
+	return
+}
+
+/*
+goDecl returns the Go variable declaration of the receiver using name,
+including its doc comment.
+*/
+func (r Record) goDecl(name string) (decl string) {
+	comment := name + ` is ` + r.Dot.String()
+	if len(r.Identifier) > 0 {
+		comment += ` (` + r.Identifier + `)`
+	}
+	if desc := join(fields(r.Description), ` `); len(desc) > 0 {
+		comment += `: ` + desc
+	}
+	if !hasSuffix(comment, `.`) {
+		comment += `.`
+	}
+
+	decl = "\t// " + comment + "\n"
+	decl += "\t" + name + " = objectid.MustNewDotNotation(`" + r.Dot.String() + "`)\n"
+
+	return
+}
+
+/*
+goVarName returns the exported Go variable name derived from the ASN.1
+identifier id, or a zero string if id is zero or invalid.
+*/
+func goVarName(id string) (name string) {
+	if !isIdentifier(id) {
+		return
+	}
+
+	name = `OID`
+	words := split(id, `-`)
+	for i := 0; i < len(words); i++ {
+		if len(words[i]) == 0 {
+			continue
+		}
+		ch, size := utf8.DecodeRuneInString(words[i])
+		name += string(unicode.ToUpper(ch)) + words[i][size:]
+	}
+
+	return
+}
@@ -0,0 +1,107 @@
+package objectid
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"testing"
+)
+
+func ExampleRegistry_ExportGo() {
+	reg := NewRegistry()
+	dot, _ := NewDotNotation(`1.3.6.1.5.5.7.3.1`)
+	_ = reg.Register(Record{Dot: *dot, Identifier: `id-kp-serverAuth`, Description: `TLS WWW server authentication`})
+
+	if err := reg.ExportGo(os.Stdout, nil, `oids`); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// // Code generated by go-objectid. DO NOT EDIT.
+	//
+	// package oids
+	//
+	// import "github.com/oid-directory/go-objectid"
+	//
+	// var (
+	// 	// OIDIdKpServerAuth is 1.3.6.1.5.5.7.3.1 (id-kp-serverAuth): TLS WWW server authentication.
+	// 	OIDIdKpServerAuth = objectid.MustNewDotNotation(`1.3.6.1.5.5.7.3.1`)
+	// )
+}
+
+func TestRegistry_ExportGo(t *testing.T) {
+	reg := newTestRegistry(t)
+	root, _ := parseArcKey(`1`)
+	_ = reg.Register(Record{Dot: root, Identifier: `iso`})
+	dup, _ := NewDotNotation(`2.25.1`)
+	_ = reg.Register(Record{Dot: *dup, Identifier: `uuid`, Description: "multi\nline"})
+
+	var buf bytes.Buffer
+	if err := reg.ExportGo(&buf, ``, `oids`); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	out := buf.String()
+	if _, err := parser.ParseFile(token.NewFileSet(), `oids.go`, buf.Bytes(), 0); err != nil {
+		t.Fatalf("%s failed: generated source does not parse: %v\n%s", t.Name(), err, out)
+	}
+
+	for _, want := range []string{
+		"OIDEnterprise ",
+		"OID_1_3_6_1_4_1_56521 ",
+		"OIDUuid ",
+		"OID_2_25_1 ",
+		"(uuid): multi line.",
+	} {
+		if !bytes.Contains(buf.Bytes(), []byte(want)) {
+			t.Errorf("%s failed: '%s' not found:\n%s", t.Name(), want, out)
+		}
+	}
+	if bytes.Contains(buf.Bytes(), []byte("OIDIso ")) {
+		t.Errorf("%s failed: root arc exported:\n%s", t.Name(), out)
+	}
+
+	buf.Reset()
+	if err := reg.ExportGo(&buf, `1.3.6.1.4.1.56521`, `oids`); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if n := bytes.Count(buf.Bytes(), []byte("MustNewDotNotation(")); n != 3 {
+		t.Errorf("%s failed: want 3 variables, got %d:\n%s", t.Name(), n, buf.String())
+	}
+
+	// An export bearing no records must still compile, and so must
+	// not import the package it does not reference.
+	buf.Reset()
+	fset := token.NewFileSet()
+	if err := NewRegistry().ExportGo(&buf, `1.3.6`, `oids`); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if f, err := parser.ParseFile(fset, `empty.go`, buf.Bytes(), 0); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if _, err = new(types.Config).Check(`oids`, fset, []*ast.File{f}, nil); err != nil {
+		t.Errorf("%s failed: empty export does not compile: %v\n%s", t.Name(), err, buf.String())
+	}
+
+	for _, bogus := range [][2]string{
+		{`bogus`, `oids`},
+		{``, `not-a-package`},
+	} {
+		if err := reg.ExportGo(&buf, bogus[0], bogus[1]); err == nil {
+			t.Errorf("%s failed: expected error for %v, got nothing", t.Name(), bogus)
+		}
+	}
+}
+
+func TestMustNewDotNotation(t *testing.T) {
+	if got := MustNewDotNotation(`1.3.6.1`).String(); got != `1.3.6.1` {
+		t.Errorf("%s failed: want '1.3.6.1', got '%s'", t.Name(), got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("%s failed: expected panic, got nothing", t.Name())
+		}
+	}()
+	MustNewDotNotation(`bogus`)
+}
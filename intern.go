@@ -0,0 +1,145 @@
+package objectid
+
+/*
+intern.go implements the sharing of NumberForm storage across instances
+of DotNotation bearing common arcs and prefixes.
+*/
+
+import "sync"
+
+/*
+internedArcs contains shared [NumberForm] instances for small arcs, which
+dominate most registration trees. Stored arcs within this range share the
+backing of these instances rather than bearing their own.
+*/
+var internedArcs = func() (arcs [256]NumberForm) {
+	for i := 0; i < len(arcs); i++ {
+		arcs[i], _ = NewNumberForm(i)
+	}
+	return
+}()
+
+/*
+internArc returns the interned instance of nf, if one exists, alongside
+a Boolean value indicative of whether interning occurred. Otherwise, nf
+is returned as-is.
+*/
+func internArc(nf NumberForm) (NumberForm, bool) {
+	if x := nf.cast(); x.IsUint64() && x.Uint64() < uint64(len(internedArcs)) {
+		return internedArcs[x.Uint64()], true
+	}
+
+	return nf, false
+}
+
+/*
+internedPrefixes contains the ubiquitous prefixes whose arcs are shared
+by all interned [DotNotation] instances bearing them, ordered from the
+longest prefix to the shortest.
+*/
+var internedPrefixes = struct {
+	sync.RWMutex
+	dots []DotNotation
+}{
+	dots: func() (dots []DotNotation) {
+		for _, key := range []string{
+			`1.2.840.113549.1.9`,
+			`1.2.840.113549.1.1`,
+			`1.3.6.1.4.1.311`,
+			`1.3.6.1.4.1`,
+			`1.2.840.113549`,
+			`1.2.840.10045`,
+			`2.16.840.1`,
+			`1.3.6.1.5.5.7`,
+			`1.2.840`,
+			`2.5.29`,
+			`2.5.4`,
+		} {
+			d, _ := parseArcKey(key)
+			dots = append(dots, internLabel(d))
+		}
+		return
+	}(),
+}
+
+/*
+RegisterInternPrefix adds dot, which can be a string or [DotNotation], to
+the package-wide set of prefixes whose arcs are shared by instances of
+[DotNotation] produced by [Intern]. Registering a prefix which is already
+present has no effect. An error is returned if dot is invalid.
+*/
+func RegisterInternPrefix(dot any) (err error) {
+	key, ok := arcKey(dot)
+	if !ok {
+		err = errorf("Invalid intern prefix: %v", dot)
+		return
+	}
+	d, _ := parseArcKey(key)
+
+	internedPrefixes.Lock()
+	defer internedPrefixes.Unlock()
+
+	dots := internedPrefixes.dots
+	idx := len(dots)
+	for i := 0; i < len(dots); i++ {
+		if equalArcs(dots[i], d) {
+			return
+		} else if idx == len(dots) && dots[i].Len() < d.Len() {
+			idx = i
+		}
+	}
+
+	// Copy on write, as readers do not hold the lock
+	// beyond obtaining the slice.
+	fresh := make([]DotNotation, 0, len(dots)+1)
+	fresh = append(append(append(fresh, dots[:idx]...), internLabel(d)), dots[idx:]...)
+	internedPrefixes.dots = fresh
+
+	return
+}
+
+/*
+Intern returns a copy of dot in which each arc is replaced by a shared
+instance, where possible: the arcs of the longest registered prefix (see
+[RegisterInternPrefix]) borne by dot are taken from that prefix, and any
+other small arcs are taken from a package-wide table. This reduces the
+steady-state memory of services which hold many [DotNotation] values.
+
+As with all read-only values, the result must not be modified through
+the [math/big] package. Methods of [DotNotation] never do so.
+*/
+func Intern(dot DotNotation) (d DotNotation) {
+	if dot.Len() == 0 {
+		return
+	}
+
+	internedPrefixes.RLock()
+	dots := internedPrefixes.dots
+	internedPrefixes.RUnlock()
+
+	d = make(DotNotation, dot.Len())
+
+	var start int
+	for i := 0; i < len(dots); i++ {
+		if dot.hasDotPrefix(dots[i]) {
+			start = copy(d, dots[i])
+			break
+		}
+	}
+
+	for i := start; i < dot.Len(); i++ {
+		d[i], _ = internArc(dot[i])
+	}
+
+	return
+}
+
+/*
+InternPrefixes returns a [ParseOption] which causes each [DotNotation]
+produced by [NewDotNotation] to be passed through [Intern].
+*/
+func InternPrefixes() ParseOption {
+	return func(cfg *parseConfig) {
+		cfg.intern = true
+	}
+}
@@ -0,0 +1,62 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleIntern() {
+	a, _ := NewDotNotation(`1.2.840.113549.1.1.11`, InternPrefixes())
+	b, _ := NewDotNotation(`1.2.840.113549.1.1.5`, InternPrefixes())
+	fmt.Println(a, b, sharesBacking((*a)[3], (*b)[3]))
+	// Output: 1.2.840.113549.1.1.11 1.2.840.113549.1.1.5 true
+}
+
+func sharesBacking(a, b NumberForm) bool {
+	x, y := a.cast().Bits(), b.cast().Bits()
+	return len(x) > 0 && len(y) > 0 && &x[0] == &y[0]
+}
+
+func TestIntern(t *testing.T) {
+	a, _ := NewDotNotation(`1.3.6.1.4.1.56521.999.7`)
+	b, _ := NewDotNotation(`1.3.6.1.4.1.56521.999.8`)
+	if sharesBacking((*a)[6], (*b)[6]) {
+		t.Fatalf("%s failed: independent parses share backing", t.Name())
+	}
+
+	if err := RegisterInternPrefix(`1.3.6.1.4.1.56521`); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if err = RegisterInternPrefix(`1.3.6.1.4.1.56521`); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if err = RegisterInternPrefix(`bogus`); err == nil {
+		t.Errorf("%s failed: no error for bogus prefix", t.Name())
+	}
+
+	x, y := Intern(*a), Intern(*b)
+	if x.String() != a.String() || y.String() != b.String() {
+		t.Fatalf("%s failed: interning altered values: %s, %s", t.Name(), x, y)
+	}
+
+	// arcs of the registered prefix are shared,
+	// whereas 999 is neither prefixed nor small.
+	for i := 0; i < 7; i++ {
+		if !sharesBacking(x[i], y[i]) {
+			t.Errorf("%s failed: arc %d not shared", t.Name(), i)
+		}
+	}
+
+	if sharesBacking(x[7], y[7]) || sharesBacking(x[8], y[8]) {
+		t.Errorf("%s failed: unprefixed arcs share backing", t.Name())
+	}
+
+	// Arcs beyond any prefix, and above the small arc
+	// table, are copied as-is.
+	big, _ := NewDotNotation(`2.25.987895962269883002155146617097157934`)
+	if z := Intern(*big); z.String() != big.String() {
+		t.Errorf("%s failed: bad interned value %s", t.Name(), z)
+	}
+
+	if Intern(DotNotation{}) != nil {
+		t.Errorf("%s failed: zero instance yielded non-nil result", t.Name())
+	}
+}
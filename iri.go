@@ -0,0 +1,385 @@
+package objectid
+
+/*
+iri.go handles IRINotation (OID-IRI) operations.
+*/
+
+/*
+IRINotation contains an ordered sequence of Unicode label string values,
+as defined in [ITU-T Rec. X.660] and clause 34 of [ITU-T Rec. X.680].
+
+The string representation of an instance of this type is known as an
+OID-IRI value (e.g.: "/Joint-ISO-ITU-T/Example" or "/2/999").
+
+[ITU-T Rec. X.660]: https://www.itu.int/rec/T-REC-X.660
+[ITU-T Rec. X.680]: https://www.itu.int/rec/T-REC-X.680
+*/
+type IRINotation []string
+
+/*
+String is a stringer method that returns the OID-IRI form of the receiver
+(e.g.: "/2/999/1").
+*/
+func (r IRINotation) String() (s string) {
+	if !r.IsZero() {
+		s = `/` + join(r, `/`)
+	}
+	return
+}
+
+/*
+EscapedString returns the OID-IRI form of the receiver with each Unicode
+label percent-encoded per RFC 3986 (e.g.: "/2/999/%E4%BE%8B"), such that
+the result may be safely used within a URL path, such as that of an OID
+resolution service. See [NewIRINotation] for the inverse operation.
+*/
+func (r IRINotation) EscapedString() (s string) {
+	if !r.IsZero() {
+		x := make([]string, r.Len())
+		for i := 0; i < r.Len(); i++ {
+			x[i] = escPath(r[i])
+		}
+		s = `/` + join(x, `/`)
+	}
+	return
+}
+
+/*
+Len returns the integer length of the receiver.
+*/
+func (r IRINotation) Len() int { return len(r) }
+
+/*
+IsZero returns a Boolean indicative of whether the receiver is unset.
+*/
+func (r IRINotation) IsZero() bool {
+	return r.Len() == 0
+}
+
+/*
+Index returns the Nth Unicode label from the receiver, alongside a Boolean
+value indicative of success. This method supports the use of negative
+indices.
+*/
+func (r IRINotation) Index(idx int) (label string, ok bool) {
+	if L := r.Len(); L > 0 {
+		if idx < 0 {
+			label = r[0]
+			if x := L + idx; x >= 0 {
+				label = r[x]
+			}
+		} else if idx >= L {
+			label = r[L-1]
+		} else {
+			label = r[idx]
+		}
+		ok = len(label) > 0
+	}
+
+	return
+}
+
+/*
+Root returns the root node (0) Unicode label from the receiver.
+*/
+func (r IRINotation) Root() string {
+	x, _ := r.Index(0)
+	return x
+}
+
+/*
+Leaf returns the leaf node (-1) Unicode label from the receiver.
+*/
+func (r IRINotation) Leaf() string {
+	x, _ := r.Index(-1)
+	return x
+}
+
+/*
+Parent returns the leaf node's parent (-2) Unicode label from the receiver.
+*/
+func (r IRINotation) Parent() string {
+	x, _ := r.Index(-2)
+	return x
+}
+
+/*
+Valid returns a Boolean value indicative of whether the receiver is
+non-zero and contains only valid Unicode labels.
+*/
+func (r IRINotation) Valid() bool {
+	return r.Validate() == nil
+}
+
+/*
+Validate returns an error describing the first invalid Unicode label
+found within the receiver, or nil if no violation is found.
+*/
+func (r IRINotation) Validate() (err error) {
+	if r.IsZero() {
+		err = errorf("%T is zero length", r)
+		return
+	}
+
+	for i := 0; i < r.Len() && err == nil; i++ {
+		if !isUnicodeLabel(r[i]) {
+			err = errorf("%T label %d is not a valid Unicode label: '%s'", r, i, r[i])
+		}
+	}
+
+	return
+}
+
+/*
+Dot returns a [DotNotation] instance based on the contents of the receiver
+instance. Integer Unicode labels (e.g.: "56521") are converted directly,
+while non-integer Unicode labels (e.g.: "Example") are resolved through the
+Unicode label registry. See [RegisterUnicodeLabels] and [RegisterLongArc].
+
+A zero instance is returned if the receiver contains an unresolvable label,
+or if the resulting [DotNotation] is invalid.
+*/
+func (r IRINotation) Dot() (d DotNotation) {
+	if !r.Valid() {
+		return
+	}
+
+	var (
+		t   DotNotation
+		err error
+	)
+
+	for i := 0; i < r.Len(); i++ {
+		if isIntegerUnicodeLabel(r[i]) {
+			var nf NumberForm
+			if nf, err = NewNumberForm(r[i]); err != nil {
+				return
+			}
+			t = append(t, nf)
+			continue
+		}
+
+		key, found := resolveUnicodeLabel(t.String(), r[i])
+		if !found {
+			return
+		}
+
+		// The resolved key is the complete path of the
+		// arc, which may differ from the parent in more
+		// than one arc in the case of a long arc.
+		if t, err = dotFromKey(key); err != nil {
+			return
+		}
+	}
+
+	if t.Valid() {
+		d = t
+	}
+
+	return
+}
+
+/*
+dotFromKey returns a [DotNotation] parsed from key, which is a dot notation
+string of any length, including a root arc alone.
+*/
+func dotFromKey(key string) (d DotNotation, err error) {
+	arcs := split(key, `.`)
+	d = make(DotNotation, len(arcs))
+	for i := 0; i < len(arcs) && err == nil; i++ {
+		d[i], err = NewNumberForm(arcs[i])
+	}
+
+	return
+}
+
+/*
+ASN returns an [ASN1Notation] instance based on the contents of the receiver
+instance. As with the [IRINotation.Dot] method, labels are resolved to their
+respective numbers, resulting in unnamed (number only) [NameAndNumberForm]
+slices.
+*/
+func (r IRINotation) ASN() (a ASN1Notation) {
+	if d := r.Dot(); !d.IsZero() {
+		a = d.asn()
+	}
+
+	return
+}
+
+/*
+IRI returns an [IRINotation] instance based on the contents of the receiver.
+Each [NumberForm] is represented by its primary Unicode label, if one is
+registered, or else as an integer Unicode label.
+*/
+func (r DotNotation) IRI() (i IRINotation) {
+	if !r.IsZero() {
+		i = make(IRINotation, r.Len())
+		for j := 0; j < r.Len(); j++ {
+			i[j] = primaryUnicodeLabel(r[:j+1].String(), r[j])
+		}
+	}
+
+	return
+}
+
+/*
+IRI returns an [IRINotation] instance based on the contents of the receiver.
+As ASN.1 identifiers are not Unicode labels, each [NumberForm] is represented
+in the same manner as [DotNotation.IRI].
+*/
+func (r ASN1Notation) IRI() (i IRINotation) {
+	if !r.IsZero() {
+		d := make(DotNotation, r.Len())
+		for j := 0; j < r.Len(); j++ {
+			d[j] = r[j].NumberForm()
+		}
+		i = d.IRI()
+	}
+
+	return
+}
+
+/*
+asn returns an unnamed [ASN1Notation] instance based on the receiver.
+*/
+func (r DotNotation) asn() (a ASN1Notation) {
+	a = make(ASN1Notation, r.Len())
+	for i := 0; i < r.Len(); i++ {
+		a[i] = NameAndNumberForm{
+			primaryIdentifier: r[i],
+			parsed:            true,
+		}
+	}
+
+	return
+}
+
+/*
+NewIRINotation returns an instance of *[IRINotation] alongside an error.
+
+Valid input forms are:
+
+  - string (e.g.: "/Joint-ISO-ITU-T/Example" or "/2/999")
+  - string slices, each representing a single Unicode label (e.g.: []string{"2", "999"})
+
+Percent-encoded labels, such as those produced by the [IRINotation.EscapedString]
+method, are decoded prior to validation. As no valid Unicode label bears a
+percent sign, this is unambiguous.
+
+Each Unicode label is validated per [ITU-T Rec. X.660].
+
+[ITU-T Rec. X.660]: https://www.itu.int/rec/T-REC-X.660
+*/
+func NewIRINotation(x any) (r *IRINotation, err error) {
+	var t IRINotation
+
+	switch tv := x.(type) {
+	case string:
+		if len(tv) < 2 || tv[0] != '/' {
+			err = errorf("OID-IRI must begin with a solidus ('/') and contain at least one label")
+			return
+		}
+		t = IRINotation(split(tv[1:], `/`))
+	case []string:
+		t = make(IRINotation, len(tv))
+		copy(t, tv)
+	default:
+		err = errorf("Unsupported %T input type: %#v", x, x)
+		return
+	}
+
+	for i := 0; i < t.Len() && err == nil; i++ {
+		if contains(t[i], `%`) {
+			if t[i], err = unescPath(t[i]); err != nil {
+				err = errorf("%T label %d is not validly percent-encoded: %s", t, i, err.Error())
+				return
+			}
+		}
+	}
+
+	if err = t.Validate(); err == nil {
+		r = new(IRINotation)
+		*r = t
+	}
+
+	return
+}
+
+/*
+isIntegerUnicodeLabel returns a Boolean value indicative of whether
+label is a non-negative decimal integer without leading zeros.
+*/
+func isIntegerUnicodeLabel(label string) bool {
+	if !isNumber(label) {
+		return false
+	}
+
+	return len(label) == 1 || label[0] != '0'
+}
+
+/*
+isUnicodeLabel returns a Boolean value indicative of whether label is an
+integer or non-integer Unicode label per ITU-T Rec. X.660. Non-integer
+labels must:
+
+  - be composed of iunreserved characters (RFC 3987)
+  - not begin or end with a hyphen
+  - not bear hyphens in both the third and fourth positions
+*/
+func isUnicodeLabel(label string) bool {
+	if len(label) == 0 {
+		return false
+	} else if isNumber(label) {
+		return isIntegerUnicodeLabel(label)
+	}
+
+	runes := []rune(label)
+	if runes[0] == '-' || runes[len(runes)-1] == '-' {
+		return false
+	} else if len(runes) >= 4 && runes[2] == '-' && runes[3] == '-' {
+		return false
+	}
+
+	for i := 0; i < len(runes); i++ {
+		if !isIUnreserved(runes[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+isIUnreserved returns a Boolean value indicative of whether rune r is
+an iunreserved character per RFC 3987 Section 2.2.
+*/
+func isIUnreserved(r rune) bool {
+	switch {
+	case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+		return true
+	case r == '-', r == '.', r == '_', r == '~':
+		return true
+	}
+
+	return isUCSChar(r)
+}
+
+/*
+isUCSChar returns a Boolean value indicative of whether rune r falls
+within the ucschar ranges defined in RFC 3987 Section 2.2.
+*/
+func isUCSChar(r rune) bool {
+	switch {
+	case 0xA0 <= r && r <= 0xD7FF,
+		0xF900 <= r && r <= 0xFDCF,
+		0xFDF0 <= r && r <= 0xFFEF:
+		return true
+	case 0x10000 <= r && r <= 0xEFFFD:
+		// planes 1 through 14, less the last
+		// two code points in each plane.
+		return r&0xFFFF <= 0xFFFD
+	}
+
+	return false
+}
@@ -0,0 +1,134 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleNewIRINotation() {
+	iri, err := NewIRINotation(`/2/999/1`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s", iri.Dot())
+	// Output: 2.999.1
+}
+
+func ExampleDotNotation_IRI() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	fmt.Printf("%s", dot.IRI())
+	// Output: /ISO/Identified-Organization/6/1/4/1/56521
+}
+
+func ExampleIRINotation_Leaf() {
+	iri, _ := NewIRINotation(`/Joint-ISO-ITU-T/Example`)
+	fmt.Printf("%s", iri.Leaf())
+	// Output: Example
+}
+
+func TestNewIRINotation(t *testing.T) {
+	for idx, input := range []any{
+		`/2/999/Example`,
+		`/Joint-ISO-ITU-T/Example`,
+		`/ISO/Identified-Organization/6`,
+		`/2/27/Ünïcödé_label.x~y`,
+		[]string{`2`, `999`},
+	} {
+		iri, err := NewIRINotation(input)
+		if err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			continue
+		}
+		if s, ok := input.(string); ok && iri.String() != s {
+			t.Errorf("%s[%d] failed: want '%s', got '%s'", t.Name(), idx, s, iri)
+		}
+	}
+
+	for idx, input := range []any{
+		``,
+		`/`,
+		`2/999`,
+		`/2//999`,
+		`/2/0999`,
+		`/2/-Example`,
+		`/2/Example-`,
+		`/2/ex--ample`,
+		`/2/Ex ample`,
+		`/2/Ex%20ample`,
+		3,
+	} {
+		if _, err := NewIRINotation(input); err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nothing", t.Name(), idx)
+		}
+	}
+}
+
+func TestIRINotation_conversion(t *testing.T) {
+	iri, _ := NewIRINotation(`/1/3/6/1`)
+	if got := iri.Dot().String(); got != `1.3.6.1` {
+		t.Errorf("%s failed: want '1.3.6.1', got '%s'", t.Name(), got)
+	}
+	if got := iri.ASN().String(); got != `{1 3 6 1}` {
+		t.Errorf("%s failed: want '{1 3 6 1}', got '%s'", t.Name(), got)
+	}
+
+	asn, _ := NewASN1Notation(`{iso(1) identified-organization(3) dod(6)}`)
+	if got := asn.IRI().String(); got != `/ISO/Identified-Organization/6` {
+		t.Errorf("%s failed: want '/ISO/Identified-Organization/6', got '%s'", t.Name(), got)
+	}
+
+	named, _ := NewIRINotation(`/ISO/Example`)
+	if named.Dot().Len() != 0 {
+		t.Errorf("%s failed: non-integer labels converted unexpectedly", t.Name())
+	}
+
+	if root := iri.Root(); root != `1` {
+		t.Errorf("%s failed: want root '1', got '%s'", t.Name(), root)
+	}
+	if parent := iri.Parent(); parent != `6` {
+		t.Errorf("%s failed: want parent '6', got '%s'", t.Name(), parent)
+	}
+
+	var zero IRINotation
+	if zero.Valid() || zero.String() != `` {
+		t.Errorf("%s failed: zero %T considered valid", t.Name(), zero)
+	}
+}
+
+func ExampleIRINotation_EscapedString() {
+	iri, _ := NewIRINotation(`/Joint-ISO-ITU-T/Example/例`)
+	fmt.Println(iri.EscapedString())
+	// Output: /Joint-ISO-ITU-T/Example/%E4%BE%8B
+}
+
+func TestIRINotation_EscapedString(t *testing.T) {
+	raw := `/2/999/Ünïcödé/例`
+	iri, err := NewIRINotation(raw)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	esc := iri.EscapedString()
+	if contains(esc, `Ü`) || contains(esc, `例`) {
+		t.Errorf("%s failed: non-ASCII content remains in '%s'", t.Name(), esc)
+	}
+
+	back, err := NewIRINotation(esc)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if back.String() != raw {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), raw, back)
+	}
+
+	for _, bogus := range []string{`/2/999/%ZZ`, `/2/999%2F1`, `/2/%20`} {
+		if _, err := NewIRINotation(bogus); err == nil {
+			t.Errorf("%s failed: no error for '%s'", t.Name(), bogus)
+		}
+	}
+
+	if (IRINotation{}).EscapedString() != `` {
+		t.Errorf("%s failed: zero instance yielded non-zero string", t.Name())
+	}
+}
@@ -0,0 +1,40 @@
+//go:build go1.23
+
+package objectid
+
+/*
+iter.go implements range-over-func iteration of Registry contents, and is
+only built by Go 1.23 and later.
+*/
+
+import "iter"
+
+/*
+All returns an iterator over the [DotNotation] and [Record] of each
+registration within the receiver, ordered in the manner described by the
+[Registry.Records] method. Iteration may be ended early by breaking out
+of the loop; no sentinel error is required.
+
+Records are drawn from a snapshot taken as iteration begins, and no lock
+is held while the loop body runs. The receiver may therefore be queried
+or modified freely within the loop, though such changes are not observed
+by the iteration in progress.
+*/
+func (r *Registry) All() iter.Seq2[DotNotation, Record] {
+	return r.Subtree(nil).All()
+}
+
+/*
+All returns an iterator over the [DotNotation] and [Record] of each
+registration within the receiver, in the manner of [Registry.All].
+*/
+func (r RegistrySubtree) All() iter.Seq2[DotNotation, Record] {
+	return func(yield func(DotNotation, Record) bool) {
+		recs := r.Records()
+		for i := 0; i < len(recs); i++ {
+			if !yield(recs[i].Dot, recs[i]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,67 @@
+//go:build go1.23
+
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleRegistry_All() {
+	reg := NewRegistry()
+	for _, dot := range []string{`2.25`, `1.3.6.1.4.1`, `1.3.6.1.4.1.56521`} {
+		_ = reg.Register(Record{Dot: mustDot(dot)})
+	}
+
+	for dot := range reg.All() {
+		fmt.Println(dot)
+	}
+	// Output:
+	// 1.3.6.1.4.1
+	// 1.3.6.1.4.1.56521
+	// 2.25
+}
+
+func TestRegistry_All(t *testing.T) {
+	reg := newTestRegistry(t)
+
+	var got []string
+	for dot, rec := range reg.All() {
+		if dot.String() != rec.Dot.String() {
+			t.Errorf("%s failed: key %s does not match record %s", t.Name(), dot, rec.Dot)
+		}
+		got = append(got, dot.String())
+	}
+
+	var want []string
+	for _, rec := range reg.Records() {
+		want = append(want, rec.Dot.String())
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("%s failed:\n\twant: %v\n\tgot:  %v", t.Name(), want, got)
+	}
+
+	// The registry may be used within the loop body, including by
+	// methods which take its locks.
+	for dot := range reg.Subtree(`1.3.6.1.4.1`).All() {
+		if _, found := reg.Lookup(dot); !found {
+			t.Errorf("%s failed: %s not found within loop", t.Name(), dot)
+		} else if err := reg.Register(Record{Dot: mustDot(dot.String() + `.1`)}); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		}
+	}
+	reg = newTestRegistry(t)
+
+	// Early termination, after which the registry must be writable.
+	var n int
+	for range reg.Subtree(`1.3.6.1.4.1`).All() {
+		if n++; n == 2 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Errorf("%s failed: want 2 iterations, got %d", t.Name(), n)
+	} else if err := reg.Register(Record{Dot: mustDot(`2.999`)}); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	}
+}
@@ -0,0 +1,182 @@
+package objectid
+
+/*
+json.go implements JSON marshaling of OID and ASN1Notation values.
+*/
+
+import (
+	"encoding/json"
+	"sync/atomic"
+)
+
+/*
+JSONForm describes the JSON representation produced when marshaling
+[OID] and [ASN1Notation] values. See [SetJSONForm].
+*/
+type JSONForm uint8
+
+const (
+	JSONFormASN1   JSONForm = iota // "{iso(1) identified-organization(3)}" (default)
+	JSONFormDot                    // "1.3"
+	JSONFormObject                 // {"asn1":"{iso(1) identified-organization(3)}","dot":"1.3"}
+)
+
+var jsonForm atomic.Uint32
+
+/*
+SetJSONForm sets the [JSONForm] used package-wide by the MarshalJSON
+methods of [OID] and [ASN1Notation], returning an error if form is
+invalid. Use the MarshalJSONForm methods of either type to select a form
+for a single call.
+
+The form affects marshaling only: unmarshaling accepts any form.
+*/
+func SetJSONForm(form JSONForm) (err error) {
+	if form > JSONFormObject {
+		err = errorf("Invalid %T (%d)", form, form)
+		return
+	}
+
+	jsonForm.Store(uint32(form))
+
+	return
+}
+
+/*
+CurrentJSONForm returns the [JSONForm] in use package-wide. See
+[SetJSONForm].
+*/
+func CurrentJSONForm() JSONForm {
+	return JSONForm(jsonForm.Load())
+}
+
+/*
+jsonObject is the structured JSON representation of an OID.
+*/
+type jsonObject struct {
+	ASN1 string `json:"asn1,omitempty"`
+	Dot  string `json:"dot,omitempty"`
+}
+
+/*
+marshalJSONForm returns the JSON encoding of asn per form.
+*/
+func marshalJSONForm(asn ASN1Notation, form JSONForm) (b []byte, err error) {
+	if len(asn) == 0 {
+		b = []byte(`null`)
+		return
+	}
+
+	switch form {
+	case JSONFormASN1:
+		b, err = json.Marshal(asn.String())
+	case JSONFormDot:
+		b, err = json.Marshal(asn.arcs().String())
+	case JSONFormObject:
+		b, err = json.Marshal(jsonObject{ASN1: asn.String(), Dot: asn.arcs().String()})
+	default:
+		err = errorf("Invalid %T (%d)", form, form)
+	}
+
+	return
+}
+
+/*
+unmarshalJSONOID returns the [OID] described by the JSON value b, which
+may bear any [JSONForm], alongside an error. A JSON null yields a zero
+[OID].
+*/
+func unmarshalJSONOID(b []byte) (o OID, err error) {
+	if string(b) == `null` {
+		return
+	}
+
+	var s string
+	if len(b) > 0 && b[0] == '{' {
+		var obj jsonObject
+		if err = json.Unmarshal(b, &obj); err != nil {
+			return
+		} else if s = obj.ASN1; len(s) == 0 {
+			s = obj.Dot
+		}
+
+		if len(s) == 0 {
+			err = errorf("JSON object bears neither asn1 nor dot values")
+		} else if err = o.UnmarshalText([]byte(s)); err == nil && len(obj.ASN1) > 0 && len(obj.Dot) > 0 {
+			var d OID
+			if err = d.UnmarshalText([]byte(obj.Dot)); err == nil && !equalArcs(o.nanf.arcs(), d.nanf.arcs()) {
+				err = errorf("JSON asn1 (%s) and dot (%s) values disagree", obj.ASN1, obj.Dot)
+			}
+		}
+		return
+	} else if err = json.Unmarshal(b, &s); err != nil {
+		return
+	}
+
+	err = o.UnmarshalText([]byte(s))
+
+	return
+}
+
+/*
+MarshalJSON implements the [encoding/json.Marshaler] interface, encoding
+the receiver per the package-wide [JSONForm] (see [SetJSONForm]). A zero
+receiver is encoded as a JSON null.
+*/
+func (r OID) MarshalJSON() ([]byte, error) {
+	return r.MarshalJSONForm(CurrentJSONForm())
+}
+
+/*
+MarshalJSONForm returns the JSON encoding of the receiver per form,
+irrespective of the package-wide [JSONForm].
+*/
+func (r OID) MarshalJSONForm(form JSONForm) ([]byte, error) {
+	return marshalJSONForm(r.nanf, form)
+}
+
+/*
+UnmarshalJSON implements the [encoding/json.Unmarshaler] interface. Any
+[JSONForm] is accepted: a JSON string is interpreted in the manner of
+[OID.UnmarshalText], while an object must bear an "asn1" or "dot" member,
+or both, in which case they must describe the same arcs. A JSON null
+yields a zero receiver. The receiver is left unmodified upon error.
+*/
+func (r *OID) UnmarshalJSON(b []byte) (err error) {
+	var o OID
+	if o, err = unmarshalJSONOID(b); err == nil {
+		*r = o
+	}
+
+	return
+}
+
+/*
+MarshalJSON implements the [encoding/json.Marshaler] interface, encoding
+the receiver per the package-wide [JSONForm] (see [SetJSONForm]). A zero
+receiver is encoded as a JSON null.
+*/
+func (r ASN1Notation) MarshalJSON() ([]byte, error) {
+	return r.MarshalJSONForm(CurrentJSONForm())
+}
+
+/*
+MarshalJSONForm returns the JSON encoding of the receiver per form,
+irrespective of the package-wide [JSONForm].
+*/
+func (r ASN1Notation) MarshalJSONForm(form JSONForm) ([]byte, error) {
+	return marshalJSONForm(r, form)
+}
+
+/*
+UnmarshalJSON implements the [encoding/json.Unmarshaler] interface in the
+manner of [OID.UnmarshalJSON].
+*/
+func (r *ASN1Notation) UnmarshalJSON(b []byte) (err error) {
+	var o OID
+	if o, err = unmarshalJSONOID(b); err == nil {
+		*r = o.nanf
+	}
+
+	return
+}
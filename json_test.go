@@ -0,0 +1,101 @@
+package objectid
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func ExampleOID_MarshalJSON() {
+	type config struct {
+		Arc OID `json:"arc"`
+	}
+
+	var cfg config
+	if err := json.Unmarshal([]byte(`{"arc":"{joint-iso-itu-t(2) example(999) test(1)}"}`), &cfg); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	b, _ := json.Marshal(cfg)
+	fmt.Println(string(b))
+	// Output: {"arc":"{joint-iso-itu-t(2) example(999) test(1)}"}
+}
+
+func ExampleOID_MarshalJSONForm() {
+	o, _ := NewOID(`{joint-iso-itu-t(2) example(999)}`)
+	b, _ := o.MarshalJSONForm(JSONFormObject)
+	fmt.Println(string(b))
+	// Output: {"asn1":"{joint-iso-itu-t(2) example(999)}","dot":"2.999"}
+}
+
+func TestJSONForm(t *testing.T) {
+	defer SetJSONForm(JSONFormASN1)
+
+	o, _ := NewOID(`{joint-iso-itu-t(2) example(999) test(1)}`)
+	for _, tc := range []struct {
+		form JSONForm
+		want string
+	}{
+		{JSONFormASN1, `"{joint-iso-itu-t(2) example(999) test(1)}"`},
+		{JSONFormDot, `"2.999.1"`},
+		{JSONFormObject, `{"asn1":"{joint-iso-itu-t(2) example(999) test(1)}","dot":"2.999.1"}`},
+	} {
+		if err := SetJSONForm(tc.form); err != nil {
+			t.Fatalf("%s failed: %v", t.Name(), err)
+		} else if CurrentJSONForm() != tc.form {
+			t.Fatalf("%s failed: form not set", t.Name())
+		}
+
+		for _, val := range []any{o, *o, o.ASN()} {
+			b, err := json.Marshal(val)
+			if err != nil {
+				t.Errorf("%s failed: %v", t.Name(), err)
+			} else if string(b) != tc.want {
+				t.Errorf("%s failed for %T: want %s, got %s", t.Name(), val, tc.want, b)
+			}
+		}
+
+		var got OID
+		if err := json.Unmarshal([]byte(tc.want), &got); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if !got.Equal(o) {
+			t.Errorf("%s failed: want %s, got %s", t.Name(), o.ASN(), got.ASN())
+		}
+
+		var asn ASN1Notation
+		if err := json.Unmarshal([]byte(tc.want), &asn); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if !asn.Equal(o.Dot()) {
+			t.Errorf("%s failed: want %s, got %s", t.Name(), o.ASN(), asn)
+		}
+	}
+
+	if err := SetJSONForm(JSONForm(9)); err == nil {
+		t.Errorf("%s failed: expected error for invalid form", t.Name())
+	} else if _, err = o.MarshalJSONForm(JSONForm(9)); err == nil {
+		t.Errorf("%s failed: expected error for invalid form", t.Name())
+	}
+
+	if b, _ := json.Marshal(OID{}); string(b) != `null` {
+		t.Errorf("%s failed: want null, got %s", t.Name(), b)
+	}
+
+	got := *o
+	for _, bad := range []string{
+		`{}`,
+		`{"asn1":"{joint-iso-itu-t(2) example(999)}","dot":"2.998"}`,
+		`"3.1"`,
+		`42`,
+	} {
+		if err := json.Unmarshal([]byte(bad), &got); err == nil {
+			t.Errorf("%s failed: expected error for %s", t.Name(), bad)
+		} else if !got.Equal(o) {
+			t.Errorf("%s failed: receiver modified upon error", t.Name())
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`null`), &got); err != nil || !got.IsZero() {
+		t.Errorf("%s failed: expected zero value from null (err: %v)", t.Name(), err)
+	}
+}
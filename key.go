@@ -0,0 +1,126 @@
+package objectid
+
+/*
+key.go implements the comparable OIDKey type.
+*/
+
+import "math/big"
+
+/*
+MaxKeyDepth defines the maximum number of arcs that may be held by an
+instance of [OIDKey].
+*/
+const MaxKeyDepth = 16
+
+/*
+OIDKey is a fixed-size, comparable representation of an OID whose arcs
+each fit within a uint64 and whose depth does not exceed [MaxKeyDepth].
+
+As instances of this type are comparable, they may be used directly as
+map keys or compared using the == operator, without the need for string
+conversion. This is useful in performance-sensitive code paths.
+
+The zero value represents an unset OID.
+*/
+type OIDKey struct {
+	arcs [MaxKeyDepth]uint64
+	n    uint8
+}
+
+/*
+NewOIDKey returns an instance of [OIDKey] alongside an error. Valid input
+types are string and [DotNotation] (or a pointer thereto).
+
+An error is returned if the input is invalid, if the number of arcs exceeds
+[MaxKeyDepth], or if any arc overflows uint64.
+*/
+func NewOIDKey(x any) (key OIDKey, err error) {
+	D := assertDotNot(x)
+	if D == nil || D.Len() == 0 {
+		err = errorf("Invalid input for %T: %v", key, x)
+		return
+	}
+
+	return D.Key()
+}
+
+/*
+Key returns an instance of [OIDKey] based upon the receiver, alongside an
+error. An error is returned if the receiver is zero, if the number of arcs
+exceeds [MaxKeyDepth], or if any arc overflows uint64.
+*/
+func (r DotNotation) Key() (key OIDKey, err error) {
+	if L := r.Len(); L == 0 {
+		err = errorf("Cannot produce %T from zero %T", key, r)
+		return
+	} else if L > MaxKeyDepth {
+		err = errorf("%T depth of %d exceeds maximum of %d", key, L, MaxKeyDepth)
+		return
+	}
+
+	for i := 0; i < r.Len(); i++ {
+		n := r[i].cast()
+		if n.Sign() < 0 || !n.IsUint64() {
+			err = errorf("Arc %d (%s) overflows uint64", i, r[i])
+			return
+		}
+		key.arcs[i] = n.Uint64()
+	}
+	key.n = uint8(r.Len())
+
+	return
+}
+
+/*
+Len returns the integer number of arcs present within the receiver.
+*/
+func (r OIDKey) Len() int {
+	return int(r.n)
+}
+
+/*
+IsZero returns a Boolean value indicative of whether the receiver is unset.
+*/
+func (r OIDKey) IsZero() bool {
+	return r.n == 0
+}
+
+/*
+Dot returns a [DotNotation] instance based upon the receiver.
+*/
+func (r OIDKey) Dot() (d DotNotation) {
+	if !r.IsZero() {
+		d = make(DotNotation, r.n)
+		for i := 0; i < int(r.n); i++ {
+			d[i] = NumberForm(*big.NewInt(0).SetUint64(r.arcs[i]))
+		}
+	}
+
+	return
+}
+
+/*
+Uint64Slice returns the arcs of the receiver as slices of uint64.
+*/
+func (r OIDKey) Uint64Slice() (slice []uint64) {
+	if !r.IsZero() {
+		slice = make([]uint64, r.n)
+		copy(slice, r.arcs[:r.n])
+	}
+
+	return
+}
+
+/*
+String returns the dot notation form of the receiver (e.g.: "1.3.6.1").
+*/
+func (r OIDKey) String() (s string) {
+	for i := 0; i < int(r.n); i++ {
+		if i > 0 {
+			s += `.`
+		}
+		s += fmtUint(r.arcs[i], 10)
+	}
+
+	return
+}
@@ -0,0 +1,56 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleNewOIDKey() {
+	key, err := NewOIDKey(`1.3.6.1.4.1.56521`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	registered := map[OIDKey]string{key: `example`}
+
+	other, _ := NewOIDKey(`1.3.6.1.4.1.56521`)
+	fmt.Println(registered[other])
+	// Output: example
+}
+
+func TestOIDKey(t *testing.T) {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521.999.5`)
+	key, err := dot.Key()
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	if key.String() != dot.String() || key.Dot().String() != dot.String() {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), dot, key)
+	} else if key.Len() != dot.Len() || len(key.Uint64Slice()) != dot.Len() {
+		t.Errorf("%s failed: unexpected length %d", t.Name(), key.Len())
+	}
+
+	other, _ := NewOIDKey(*dot)
+	if key != other {
+		t.Errorf("%s failed: equal keys did not compare as equal", t.Name())
+	}
+
+	var zero OIDKey
+	if !zero.IsZero() || zero.String() != `` || zero.Dot() != nil || zero.Uint64Slice() != nil {
+		t.Errorf("%s failed: bogus zero %T", t.Name(), zero)
+	}
+
+	for idx, bogus := range []any{
+		``,
+		`2.25.987895962269883002155146617097157934`,
+		`1.2.3.4.5.6.7.8.9.10.11.12.13.14.15.16.17`,
+		DotNotation{},
+	} {
+		if _, err = NewOIDKey(bogus); err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nothing", t.Name(), idx)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Package known exports [objectid.DotNotation] variables for well-known
+OIDs, including those of PKIX, X.500 attribute types, common signature
+and hash algorithms, LDAP controls and extended operations, and the SNMP
+(Internet) subtree.
+
+Each variable is an independent value, and callers which modify one (for
+example, through [objectid.DotNotation.SetIndex]) affect only their own
+program. Treat them as read-only.
+*/
+package known
+
+import "github.com/oid-directory/go-objectid"
+
+/*
+dot returns the [objectid.DotNotation] parsed from raw, and panics if raw
+is invalid. This is only used to initialize package variables, all of
+which are verified by tests.
+*/
+func dot(raw string) objectid.DotNotation {
+	d, err := objectid.NewDotNotation(raw)
+	if err != nil {
+		panic(err)
+	}
+	return *d
+}
+
+/*
+SNMP (Internet) subtree roots, per RFC 1155 and RFC 2578.
+*/
+var (
+	Internet     = dot(`1.3.6.1`)
+	Directory    = dot(`1.3.6.1.1`)
+	Mgmt         = dot(`1.3.6.1.2`)
+	MIB2         = dot(`1.3.6.1.2.1`)
+	Experimental = dot(`1.3.6.1.3`)
+	Private      = dot(`1.3.6.1.4`)
+	Enterprise   = dot(`1.3.6.1.4.1`)
+	Security     = dot(`1.3.6.1.5`)
+	SNMPv2       = dot(`1.3.6.1.6`)
+	SNMPModules  = dot(`1.3.6.1.6.3`)
+)
+
+/*
+X.500 attribute types, per ITU-T Rec. X.520, as well as those commonly
+found within certificate subject names.
+*/
+var (
+	AttrCommonName             = dot(`2.5.4.3`)
+	AttrSurname                = dot(`2.5.4.4`)
+	AttrSerialNumber           = dot(`2.5.4.5`)
+	AttrCountryName            = dot(`2.5.4.6`)
+	AttrLocalityName           = dot(`2.5.4.7`)
+	AttrStateOrProvinceName    = dot(`2.5.4.8`)
+	AttrStreetAddress          = dot(`2.5.4.9`)
+	AttrOrganizationName       = dot(`2.5.4.10`)
+	AttrOrganizationalUnitName = dot(`2.5.4.11`)
+	AttrTitle                  = dot(`2.5.4.12`)
+	AttrGivenName              = dot(`2.5.4.42`)
+	AttrInitials               = dot(`2.5.4.43`)
+	AttrDNQualifier            = dot(`2.5.4.46`)
+	AttrPseudonym              = dot(`2.5.4.65`)
+	AttrUserID                 = dot(`0.9.2342.19200300.100.1.1`)
+	AttrDomainComponent        = dot(`0.9.2342.19200300.100.1.25`)
+	AttrEmailAddress           = dot(`1.2.840.113549.1.9.1`)
+)
+
+/*
+Certificate extensions, per RFC 5280.
+*/
+var (
+	ExtSubjectKeyIdentifier   = dot(`2.5.29.14`)
+	ExtKeyUsage               = dot(`2.5.29.15`)
+	ExtSubjectAltName         = dot(`2.5.29.17`)
+	ExtIssuerAltName          = dot(`2.5.29.18`)
+	ExtBasicConstraints       = dot(`2.5.29.19`)
+	ExtCRLNumber              = dot(`2.5.29.20`)
+	ExtNameConstraints        = dot(`2.5.29.30`)
+	ExtCRLDistributionPoints  = dot(`2.5.29.31`)
+	ExtCertificatePolicies    = dot(`2.5.29.32`)
+	ExtAuthorityKeyIdentifier = dot(`2.5.29.35`)
+	ExtExtendedKeyUsage       = dot(`2.5.29.37`)
+	ExtAuthorityInfoAccess    = dot(`1.3.6.1.5.5.7.1.1`)
+	AnyPolicy                 = dot(`2.5.29.32.0`)
+)
+
+/*
+PKIX arcs, extended key usage purposes and access methods, per RFC 5280.
+*/
+var (
+	PKIX                = dot(`1.3.6.1.5.5.7`)
+	PKIXExtensions      = dot(`1.3.6.1.5.5.7.1`)
+	PKIXKeyPurposes     = dot(`1.3.6.1.5.5.7.3`)
+	PKIXAccessMethods   = dot(`1.3.6.1.5.5.7.48`)
+	KPServerAuth        = dot(`1.3.6.1.5.5.7.3.1`)
+	KPClientAuth        = dot(`1.3.6.1.5.5.7.3.2`)
+	KPCodeSigning       = dot(`1.3.6.1.5.5.7.3.3`)
+	KPEmailProtection   = dot(`1.3.6.1.5.5.7.3.4`)
+	KPTimeStamping      = dot(`1.3.6.1.5.5.7.3.8`)
+	KPOCSPSigning       = dot(`1.3.6.1.5.5.7.3.9`)
+	AccessMethodOCSP    = dot(`1.3.6.1.5.5.7.48.1`)
+	AccessMethodIssuers = dot(`1.3.6.1.5.5.7.48.2`)
+)
+
+/*
+Public key and signature algorithms, per RFC 8017, RFC 5758 and RFC 8410.
+*/
+var (
+	AlgRSAEncryption   = dot(`1.2.840.113549.1.1.1`)
+	AlgSHA1WithRSA     = dot(`1.2.840.113549.1.1.5`)
+	AlgRSASSAPSS       = dot(`1.2.840.113549.1.1.10`)
+	AlgSHA256WithRSA   = dot(`1.2.840.113549.1.1.11`)
+	AlgSHA384WithRSA   = dot(`1.2.840.113549.1.1.12`)
+	AlgSHA512WithRSA   = dot(`1.2.840.113549.1.1.13`)
+	AlgECPublicKey     = dot(`1.2.840.10045.2.1`)
+	AlgECDSAWithSHA256 = dot(`1.2.840.10045.4.3.2`)
+	AlgECDSAWithSHA384 = dot(`1.2.840.10045.4.3.3`)
+	AlgECDSAWithSHA512 = dot(`1.2.840.10045.4.3.4`)
+	AlgX25519          = dot(`1.3.101.110`)
+	AlgEd25519         = dot(`1.3.101.112`)
+	AlgEd448           = dot(`1.3.101.113`)
+	CurveP256          = dot(`1.2.840.10045.3.1.7`)
+	CurveP384          = dot(`1.3.132.0.34`)
+	CurveP521          = dot(`1.3.132.0.35`)
+)
+
+/*
+Hash algorithms, per RFC 3279 and RFC 5754.
+*/
+var (
+	HashMD5    = dot(`1.2.840.113549.2.5`)
+	HashSHA1   = dot(`1.3.14.3.2.26`)
+	HashSHA256 = dot(`2.16.840.1.101.3.4.2.1`)
+	HashSHA384 = dot(`2.16.840.1.101.3.4.2.2`)
+	HashSHA512 = dot(`2.16.840.1.101.3.4.2.3`)
+)
+
+/*
+LDAP controls, per RFC 2696, RFC 2891, RFC 3296, RFC 4370, RFC 4527,
+RFC 4528 and RFC 4533, as well as draft-behera-ldap-password-policy and
+draft-ietf-ldapext-ldapv3-vlv.
+*/
+var (
+	LDAPControlPagedResults   = dot(`1.2.840.113556.1.4.319`)
+	LDAPControlServerSideSort = dot(`1.2.840.113556.1.4.473`)
+	LDAPControlManageDsaIT    = dot(`2.16.840.1.113730.3.4.2`)
+	LDAPControlVLVRequest     = dot(`2.16.840.1.113730.3.4.9`)
+	LDAPControlProxiedAuthz   = dot(`2.16.840.1.113730.3.4.18`)
+	LDAPControlAssertion      = dot(`1.3.6.1.1.12`)
+	LDAPControlPreRead        = dot(`1.3.6.1.1.13.1`)
+	LDAPControlPostRead       = dot(`1.3.6.1.1.13.2`)
+	LDAPControlSyncRequest    = dot(`1.3.6.1.4.1.4203.1.9.1.1`)
+	LDAPControlPasswordPolicy = dot(`1.3.6.1.4.1.42.2.27.8.5.1`)
+)
+
+/*
+LDAP extended operations, per RFC 3062, RFC 3909, RFC 4511 and RFC 4532.
+*/
+var (
+	LDAPExtStartTLS       = dot(`1.3.6.1.4.1.1466.20037`)
+	LDAPExtPasswordModify = dot(`1.3.6.1.4.1.4203.1.11.1`)
+	LDAPExtWhoAmI         = dot(`1.3.6.1.4.1.4203.1.11.3`)
+	LDAPExtCancel         = dot(`1.3.6.1.1.8`)
+)
@@ -0,0 +1,190 @@
+package known
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/oid-directory/go-objectid"
+)
+
+func TestKnown(t *testing.T) {
+	table := []struct {
+		name string
+		got  objectid.DotNotation
+		want string
+	}{
+		{`Internet`, Internet, `1.3.6.1`},
+		{`Directory`, Directory, `1.3.6.1.1`},
+		{`Mgmt`, Mgmt, `1.3.6.1.2`},
+		{`MIB2`, MIB2, `1.3.6.1.2.1`},
+		{`Experimental`, Experimental, `1.3.6.1.3`},
+		{`Private`, Private, `1.3.6.1.4`},
+		{`Enterprise`, Enterprise, `1.3.6.1.4.1`},
+		{`Security`, Security, `1.3.6.1.5`},
+		{`SNMPv2`, SNMPv2, `1.3.6.1.6`},
+		{`SNMPModules`, SNMPModules, `1.3.6.1.6.3`},
+		{`AttrCommonName`, AttrCommonName, `2.5.4.3`},
+		{`AttrSurname`, AttrSurname, `2.5.4.4`},
+		{`AttrSerialNumber`, AttrSerialNumber, `2.5.4.5`},
+		{`AttrCountryName`, AttrCountryName, `2.5.4.6`},
+		{`AttrLocalityName`, AttrLocalityName, `2.5.4.7`},
+		{`AttrStateOrProvinceName`, AttrStateOrProvinceName, `2.5.4.8`},
+		{`AttrStreetAddress`, AttrStreetAddress, `2.5.4.9`},
+		{`AttrOrganizationName`, AttrOrganizationName, `2.5.4.10`},
+		{`AttrOrganizationalUnitName`, AttrOrganizationalUnitName, `2.5.4.11`},
+		{`AttrTitle`, AttrTitle, `2.5.4.12`},
+		{`AttrGivenName`, AttrGivenName, `2.5.4.42`},
+		{`AttrInitials`, AttrInitials, `2.5.4.43`},
+		{`AttrDNQualifier`, AttrDNQualifier, `2.5.4.46`},
+		{`AttrPseudonym`, AttrPseudonym, `2.5.4.65`},
+		{`AttrUserID`, AttrUserID, `0.9.2342.19200300.100.1.1`},
+		{`AttrDomainComponent`, AttrDomainComponent, `0.9.2342.19200300.100.1.25`},
+		{`AttrEmailAddress`, AttrEmailAddress, `1.2.840.113549.1.9.1`},
+		{`ExtSubjectKeyIdentifier`, ExtSubjectKeyIdentifier, `2.5.29.14`},
+		{`ExtKeyUsage`, ExtKeyUsage, `2.5.29.15`},
+		{`ExtSubjectAltName`, ExtSubjectAltName, `2.5.29.17`},
+		{`ExtIssuerAltName`, ExtIssuerAltName, `2.5.29.18`},
+		{`ExtBasicConstraints`, ExtBasicConstraints, `2.5.29.19`},
+		{`ExtCRLNumber`, ExtCRLNumber, `2.5.29.20`},
+		{`ExtNameConstraints`, ExtNameConstraints, `2.5.29.30`},
+		{`ExtCRLDistributionPoints`, ExtCRLDistributionPoints, `2.5.29.31`},
+		{`ExtCertificatePolicies`, ExtCertificatePolicies, `2.5.29.32`},
+		{`ExtAuthorityKeyIdentifier`, ExtAuthorityKeyIdentifier, `2.5.29.35`},
+		{`ExtExtendedKeyUsage`, ExtExtendedKeyUsage, `2.5.29.37`},
+		{`ExtAuthorityInfoAccess`, ExtAuthorityInfoAccess, `1.3.6.1.5.5.7.1.1`},
+		{`AnyPolicy`, AnyPolicy, `2.5.29.32.0`},
+		{`PKIX`, PKIX, `1.3.6.1.5.5.7`},
+		{`PKIXExtensions`, PKIXExtensions, `1.3.6.1.5.5.7.1`},
+		{`PKIXKeyPurposes`, PKIXKeyPurposes, `1.3.6.1.5.5.7.3`},
+		{`PKIXAccessMethods`, PKIXAccessMethods, `1.3.6.1.5.5.7.48`},
+		{`KPServerAuth`, KPServerAuth, `1.3.6.1.5.5.7.3.1`},
+		{`KPClientAuth`, KPClientAuth, `1.3.6.1.5.5.7.3.2`},
+		{`KPCodeSigning`, KPCodeSigning, `1.3.6.1.5.5.7.3.3`},
+		{`KPEmailProtection`, KPEmailProtection, `1.3.6.1.5.5.7.3.4`},
+		{`KPTimeStamping`, KPTimeStamping, `1.3.6.1.5.5.7.3.8`},
+		{`KPOCSPSigning`, KPOCSPSigning, `1.3.6.1.5.5.7.3.9`},
+		{`AccessMethodOCSP`, AccessMethodOCSP, `1.3.6.1.5.5.7.48.1`},
+		{`AccessMethodIssuers`, AccessMethodIssuers, `1.3.6.1.5.5.7.48.2`},
+		{`AlgRSAEncryption`, AlgRSAEncryption, `1.2.840.113549.1.1.1`},
+		{`AlgSHA1WithRSA`, AlgSHA1WithRSA, `1.2.840.113549.1.1.5`},
+		{`AlgRSASSAPSS`, AlgRSASSAPSS, `1.2.840.113549.1.1.10`},
+		{`AlgSHA256WithRSA`, AlgSHA256WithRSA, `1.2.840.113549.1.1.11`},
+		{`AlgSHA384WithRSA`, AlgSHA384WithRSA, `1.2.840.113549.1.1.12`},
+		{`AlgSHA512WithRSA`, AlgSHA512WithRSA, `1.2.840.113549.1.1.13`},
+		{`AlgECPublicKey`, AlgECPublicKey, `1.2.840.10045.2.1`},
+		{`AlgECDSAWithSHA256`, AlgECDSAWithSHA256, `1.2.840.10045.4.3.2`},
+		{`AlgECDSAWithSHA384`, AlgECDSAWithSHA384, `1.2.840.10045.4.3.3`},
+		{`AlgECDSAWithSHA512`, AlgECDSAWithSHA512, `1.2.840.10045.4.3.4`},
+		{`AlgX25519`, AlgX25519, `1.3.101.110`},
+		{`AlgEd25519`, AlgEd25519, `1.3.101.112`},
+		{`AlgEd448`, AlgEd448, `1.3.101.113`},
+		{`CurveP256`, CurveP256, `1.2.840.10045.3.1.7`},
+		{`CurveP384`, CurveP384, `1.3.132.0.34`},
+		{`CurveP521`, CurveP521, `1.3.132.0.35`},
+		{`HashMD5`, HashMD5, `1.2.840.113549.2.5`},
+		{`HashSHA1`, HashSHA1, `1.3.14.3.2.26`},
+		{`HashSHA256`, HashSHA256, `2.16.840.1.101.3.4.2.1`},
+		{`HashSHA384`, HashSHA384, `2.16.840.1.101.3.4.2.2`},
+		{`HashSHA512`, HashSHA512, `2.16.840.1.101.3.4.2.3`},
+		{`LDAPControlPagedResults`, LDAPControlPagedResults, `1.2.840.113556.1.4.319`},
+		{`LDAPControlServerSideSort`, LDAPControlServerSideSort, `1.2.840.113556.1.4.473`},
+		{`LDAPControlManageDsaIT`, LDAPControlManageDsaIT, `2.16.840.1.113730.3.4.2`},
+		{`LDAPControlVLVRequest`, LDAPControlVLVRequest, `2.16.840.1.113730.3.4.9`},
+		{`LDAPControlProxiedAuthz`, LDAPControlProxiedAuthz, `2.16.840.1.113730.3.4.18`},
+		{`LDAPControlAssertion`, LDAPControlAssertion, `1.3.6.1.1.12`},
+		{`LDAPControlPreRead`, LDAPControlPreRead, `1.3.6.1.1.13.1`},
+		{`LDAPControlPostRead`, LDAPControlPostRead, `1.3.6.1.1.13.2`},
+		{`LDAPControlSyncRequest`, LDAPControlSyncRequest, `1.3.6.1.4.1.4203.1.9.1.1`},
+		{`LDAPControlPasswordPolicy`, LDAPControlPasswordPolicy, `1.3.6.1.4.1.42.2.27.8.5.1`},
+		{`LDAPExtStartTLS`, LDAPExtStartTLS, `1.3.6.1.4.1.1466.20037`},
+		{`LDAPExtPasswordModify`, LDAPExtPasswordModify, `1.3.6.1.4.1.4203.1.11.1`},
+		{`LDAPExtWhoAmI`, LDAPExtWhoAmI, `1.3.6.1.4.1.4203.1.11.3`},
+		{`LDAPExtCancel`, LDAPExtCancel, `1.3.6.1.1.8`},
+	}
+
+	seen := make(map[string]bool, len(table))
+	for _, tc := range table {
+		seen[tc.name] = true
+		if tc.got.String() != tc.want {
+			t.Errorf("%s failed: %s want %s, got %s", t.Name(), tc.name, tc.want, tc.got)
+		}
+	}
+
+	// Every exported variable must be verified above.
+	f, err := parser.ParseFile(token.NewFileSet(), `known.go`, nil, 0)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+	var n int
+	for _, decl := range f.Decls {
+		if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.VAR {
+			for _, spec := range gd.Specs {
+				for _, id := range spec.(*ast.ValueSpec).Names {
+					if n++; !seen[id.Name] {
+						t.Errorf("%s failed: %s is not verified", t.Name(), id.Name)
+					}
+				}
+			}
+		}
+	}
+	if n != len(table) {
+		t.Errorf("%s failed: want %d variables, found %d", t.Name(), len(table), n)
+	}
+}
+
+func TestKnown_objectNames(t *testing.T) {
+	// Cross-check against the object name table
+	// of the parent package, where applicable.
+	for sn, got := range map[string]objectid.DotNotation{
+		`CN`:                  AttrCommonName,
+		`C`:                   AttrCountryName,
+		`O`:                   AttrOrganizationName,
+		`OU`:                  AttrOrganizationalUnitName,
+		`DC`:                  AttrDomainComponent,
+		`emailAddress`:        AttrEmailAddress,
+		`subjectAltName`:      ExtSubjectAltName,
+		`basicConstraints`:    ExtBasicConstraints,
+		`extendedKeyUsage`:    ExtExtendedKeyUsage,
+		`authorityInfoAccess`: ExtAuthorityInfoAccess,
+		`serverAuth`:          KPServerAuth,
+		`OCSPSigning`:         KPOCSPSigning,
+		`caIssuers`:           AccessMethodIssuers,
+		`rsaEncryption`:       AlgRSAEncryption,
+		`RSASSA-PSS`:          AlgRSASSAPSS,
+		`ecdsa-with-SHA384`:   AlgECDSAWithSHA384,
+		`ED25519`:             AlgEd25519,
+		`prime256v1`:          CurveP256,
+		`secp521r1`:           CurveP521,
+		`SHA256`:              HashSHA256,
+		`MD5`:                 HashMD5,
+	} {
+		if want, ok := objectid.LookupShortName(sn); !ok || !got.Equal(want) {
+			t.Errorf("%s failed: %s want %s, got %s", t.Name(), sn, want, got)
+		}
+	}
+}
+
+func TestKnown_valid(t *testing.T) {
+	for _, d := range []objectid.DotNotation{
+		Internet, Directory, Mgmt, MIB2, Experimental, Private, Security, SNMPv2,
+		AttrSurname, AttrSerialNumber, AttrLocalityName, AttrStateOrProvinceName,
+		AttrStreetAddress, AttrTitle, AttrGivenName, AttrInitials, AttrDNQualifier,
+		AttrPseudonym, AttrUserID, ExtSubjectKeyIdentifier, ExtKeyUsage,
+		ExtIssuerAltName, ExtCRLNumber, ExtNameConstraints, ExtCRLDistributionPoints,
+		ExtCertificatePolicies, ExtAuthorityKeyIdentifier, PKIX, PKIXExtensions,
+		PKIXKeyPurposes, PKIXAccessMethods, KPClientAuth, KPCodeSigning,
+		KPEmailProtection, KPTimeStamping, AlgSHA1WithRSA, AlgSHA384WithRSA,
+		AlgSHA512WithRSA, AlgECPublicKey, AlgECDSAWithSHA256, AlgECDSAWithSHA512,
+		AlgX25519, CurveP384, HashSHA1, HashSHA384, LDAPControlServerSideSort,
+		LDAPControlManageDsaIT, LDAPControlVLVRequest, LDAPControlProxiedAuthz,
+		LDAPControlAssertion, LDAPControlPreRead, LDAPControlPostRead,
+		LDAPControlSyncRequest, LDAPControlPasswordPolicy, LDAPExtPasswordModify,
+		LDAPExtWhoAmI, LDAPExtCancel,
+	} {
+		if !d.Valid() {
+			t.Errorf("%s failed: invalid value %s", t.Name(), d)
+		}
+	}
+}
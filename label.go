@@ -0,0 +1,197 @@
+package objectid
+
+/*
+label.go contains the Unicode label registry used during IRINotation
+conversion.
+*/
+
+import "sync"
+
+/*
+unicodeLabels contains the known Unicode labels for individual arcs, keyed
+by the dot notation of the arc (e.g.: "2.999"). The first label registered
+for an arc is considered its primary label.
+
+The byLabel map indexes each arc by its parent and label, allowing quick
+resolution during IRINotation conversion. Long arcs are tracked separately,
+keyed by label, as they may appear directly beneath the OID-IRI root.
+*/
+var unicodeLabels = struct {
+	sync.RWMutex
+	byArc    map[string][]string
+	byLabel  map[string]string
+	longArcs map[string]string
+}{
+	byArc: map[string][]string{
+		`0`:     {`ITU-T`},
+		`0.0`:   {`Recommendation`},
+		`0.2`:   {`Administration`},
+		`0.3`:   {`Network-Operator`},
+		`0.4`:   {`Identified-Organization`},
+		`1`:     {`ISO`},
+		`1.0`:   {`Standard`},
+		`1.1`:   {`Registration-Authority`},
+		`1.2`:   {`Member-Body`},
+		`1.3`:   {`Identified-Organization`},
+		`2`:     {`Joint-ISO-ITU-T`},
+		`2.1`:   {`ASN.1`},
+		`2.16`:  {`Country`},
+		`2.23`:  {`International-Organizations`},
+		`2.25`:  {`UUID`},
+		`2.27`:  {`Tag-Based`},
+		`2.999`: {`Example`},
+	},
+	byLabel: make(map[string]string),
+	longArcs: map[string]string{
+		`ASN.1`:                       `2.1`,
+		`Country`:                     `2.16`,
+		`International-Organizations`: `2.23`,
+		`UUID`:                        `2.25`,
+		`Tag-Based`:                   `2.27`,
+		`Example`:                     `2.999`,
+	},
+}
+
+func init() {
+	for key, labels := range unicodeLabels.byArc {
+		for i := 0; i < len(labels); i++ {
+			unicodeLabels.byLabel[labelIndexKey(parentOfKey(key), labels[i])] = key
+		}
+	}
+}
+
+/*
+RegisterUnicodeLabels assigns one (1) or more Unicode labels to the arc
+identified by dot, which can be a string (e.g.: "2.999") or [DotNotation].
+The first label ever registered for an arc is considered its primary label,
+and is used when rendering an [IRINotation].
+
+An error is returned if dot is invalid, if any label is not a valid
+non-integer Unicode label, or if a label is already assigned to a sibling
+arc.
+*/
+func RegisterUnicodeLabels(dot any, labels ...string) error {
+	return registerUnicodeLabels(dot, false, labels...)
+}
+
+/*
+RegisterLongArc assigns one (1) or more Unicode labels to a long arc beneath
+joint-iso-itu-t(2), such as "Example" for 2.999. Per ITU-T Rec. X.660, long
+arcs may be referenced directly beneath the root of an OID-IRI value (e.g.:
+"/Example" in lieu of "/Joint-ISO-ITU-T/Example").
+*/
+func RegisterLongArc(dot any, labels ...string) error {
+	return registerUnicodeLabels(dot, true, labels...)
+}
+
+func registerUnicodeLabels(dot any, long bool, labels ...string) (err error) {
+	key, ok := arcKey(dot)
+	if !ok {
+		err = errorf("Invalid arc for Unicode label registration: %v", dot)
+		return
+	}
+
+	parent := parentOfKey(key)
+	if long && parent != `2` {
+		err = errorf("Long arcs must be second-level arcs beneath joint-iso-itu-t(2)")
+		return
+	} else if len(labels) == 0 {
+		err = errorf("No Unicode labels provided for %s", key)
+		return
+	}
+
+	unicodeLabels.Lock()
+	defer unicodeLabels.Unlock()
+
+	for i := 0; i < len(labels); i++ {
+		label := labels[i]
+		if !isUnicodeLabel(label) || isNumber(label) {
+			err = errorf("Invalid non-integer Unicode label '%s'", label)
+			return
+		} else if k, found := unicodeLabels.byLabel[labelIndexKey(parent, label)]; found && k != key {
+			err = errorf("Unicode label '%s' already assigned to %s", label, k)
+			return
+		}
+	}
+
+	for i := 0; i < len(labels); i++ {
+		if !strInSlice(labels[i], unicodeLabels.byArc[key]) {
+			unicodeLabels.byArc[key] = append(unicodeLabels.byArc[key], labels[i])
+		}
+		unicodeLabels.byLabel[labelIndexKey(parent, labels[i])] = key
+		if long {
+			unicodeLabels.longArcs[labels[i]] = key
+		}
+	}
+
+	return
+}
+
+/*
+UnicodeLabels returns all Unicode labels registered for the arc identified
+by dot, which can be a string or [DotNotation]. The primary label, if any,
+is always the first slice member.
+*/
+func UnicodeLabels(dot any) (labels []string) {
+	if key, ok := arcKey(dot); ok {
+		unicodeLabels.RLock()
+		defer unicodeLabels.RUnlock()
+
+		if l, found := unicodeLabels.byArc[key]; found {
+			labels = make([]string, len(l))
+			copy(labels, l)
+		}
+	}
+
+	return
+}
+
+/*
+primaryUnicodeLabel returns the primary Unicode label registered for the
+arc identified by key. If none is registered, the string representation
+of nf is returned.
+*/
+func primaryUnicodeLabel(key string, nf NumberForm) (label string) {
+	unicodeLabels.RLock()
+	defer unicodeLabels.RUnlock()
+
+	if l := unicodeLabels.byArc[key]; len(l) > 0 {
+		label = l[0]
+	} else {
+		label = nf.String()
+	}
+
+	return
+}
+
+/*
+resolveUnicodeLabel returns the dot notation key of the arc bearing label
+beneath the arc identified by parent. A zero parent indicates the label is
+to be resolved from the OID-IRI root, wherein long arcs are honored.
+*/
+func resolveUnicodeLabel(parent, label string) (key string, found bool) {
+	unicodeLabels.RLock()
+	defer unicodeLabels.RUnlock()
+
+	if key, found = unicodeLabels.byLabel[labelIndexKey(parent, label)]; !found && parent == `` {
+		key, found = unicodeLabels.longArcs[label]
+	}
+
+	return
+}
+
+func labelIndexKey(parent, label string) string {
+	return parent + `/` + label
+}
+
+/*
+parentOfKey returns the dot notation of the parent of the arc identified
+by key, or a zero string if key is a root arc.
+*/
+func parentOfKey(key string) (parent string) {
+	if idx := lastIndex(key, `.`); idx != -1 {
+		parent = key[:idx]
+	}
+
+	return
+}
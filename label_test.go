@@ -0,0 +1,85 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleRegisterUnicodeLabels() {
+	if err := RegisterUnicodeLabels(`2.999.1`, `Widgets`); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	iri, _ := NewIRINotation(`/Example/Widgets/5`)
+	fmt.Printf("%s", iri.Dot())
+	// Output: 2.999.1.5
+}
+
+func ExampleUnicodeLabels() {
+	fmt.Printf("%v", UnicodeLabels(`2.25`))
+	// Output: [UUID]
+}
+
+func TestUnicodeLabels_conversion(t *testing.T) {
+	for iri, want := range map[string]string{
+		`/Joint-ISO-ITU-T/Example`:          `2.999`,
+		`/Example/1`:                        `2.999.1`,
+		`/UUID/987895962269883002155146617`: `2.25.987895962269883002155146617`,
+		`/ISO/Member-Body/840`:              `1.2.840`,
+		`/ITU-T/Recommendation`:             `0.0`,
+		`/2/Tag-Based`:                      `2.27`,
+		`/ISO/Example`:                      ``,
+		`/Bogus/1`:                          ``,
+	} {
+		I, err := NewIRINotation(iri)
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			continue
+		}
+		if got := I.Dot().String(); got != want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		}
+	}
+
+	dot, _ := NewDotNotation(`2.999.1`)
+	if got := dot.IRI().String(); got != `/Joint-ISO-ITU-T/Example/1` {
+		t.Errorf("%s failed: unexpected IRI '%s'", t.Name(), got)
+	}
+}
+
+func TestRegisterUnicodeLabels(t *testing.T) {
+	if err := RegisterUnicodeLabels(`2.999.2`, `Gadgets`, `Gizmos`); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	}
+	if labels := UnicodeLabels(`2.999.2`); len(labels) != 2 || labels[0] != `Gadgets` {
+		t.Errorf("%s failed: unexpected labels %v", t.Name(), labels)
+	}
+
+	for idx, args := range [][]any{
+		{`2.999.3`, `Gadgets`}, // label used by sibling
+		{`2.999.3`, `-bogus`},  // bad label
+		{`2.999.3`, `5`},       // integer label
+		{`2.999.3`},            // no labels
+		{``, `Foo`},            // bad arc
+	} {
+		var labels []string
+		for _, l := range args[1:] {
+			labels = append(labels, l.(string))
+		}
+		if err := RegisterUnicodeLabels(args[0], labels...); err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nothing", t.Name(), idx)
+		}
+	}
+
+	if err := RegisterLongArc(`2.999.4`, `Deep`); err == nil {
+		t.Errorf("%s failed: expected error for non-second-level long arc", t.Name())
+	}
+	if err := RegisterLongArc(`2.998`, `Sample`); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	}
+	iri, _ := NewIRINotation(`/Sample/1`)
+	if got := iri.Dot().String(); got != `2.998.1` {
+		t.Errorf("%s failed: want '2.998.1', got '%s'", t.Name(), got)
+	}
+}
@@ -0,0 +1,235 @@
+package objectid
+
+/*
+ldap.go contains helpers for LDAP schema and directory tooling, per
+RFC 4512.
+*/
+
+/*
+OIDTokenKind describes the classification of an [OIDToken].
+*/
+type OIDTokenKind uint8
+
+const (
+	InvalidToken    OIDTokenKind = iota // neither a numericoid nor a descr
+	NumericOIDToken                     // a numericoid (e.g.: "2.5.4.3")
+	DescrToken                          // a descr, or short name (e.g.: "cn")
+)
+
+/*
+String returns the string representation of the receiver.
+*/
+func (r OIDTokenKind) String() (s string) {
+	switch r {
+	case NumericOIDToken:
+		s = `numericoid`
+	case DescrToken:
+		s = `descr`
+	default:
+		s = `invalid`
+	}
+
+	return
+}
+
+/*
+OIDToken is the result of [ClassifyOIDToken], bearing the classification
+of the input token alongside the token itself.
+*/
+type OIDToken struct {
+	Kind  OIDTokenKind
+	Value string
+}
+
+/*
+Dot returns the [DotNotation] form of the receiver, alongside an error.
+An error is returned if the receiver is not a [NumericOIDToken], or if it
+does not qualify as a valid [DotNotation].
+*/
+func (r OIDToken) Dot() (d DotNotation, err error) {
+	if r.Kind != NumericOIDToken {
+		err = errorf("%T '%s' is not a %s", r, r.Value, NumericOIDToken)
+		return
+	}
+
+	var D *DotNotation
+	if D, err = NewDotNotation(r.Value); err == nil {
+		d = *D
+	}
+
+	return
+}
+
+/*
+ClassifyOIDToken returns an instance of [OIDToken] which classifies token
+per the oid production of [RFC 4512 Section 1.4]:
+
+	oid = descr / numericoid
+	descr = keystring
+	keystring = leadkeychar *keychar
+	leadkeychar = ALPHA
+	keychar = ALPHA / DIGIT / HYPHEN
+	numericoid = number 1*( DOT number )
+	number = DIGIT / ( LDIGIT 1*DIGIT )
+
+Unlike [IsIdentifier], which evaluates ASN.1 identifiers, descriptors may
+begin with an uppercase letter and may bear consecutive or trailing hyphens.
+Only ASCII characters are permitted. Note that a numericoid need not satisfy
+the additional constraints imposed upon [DotNotation] values, such as the
+maximum root arc of two (2); see [OIDToken.Dot].
+
+[RFC 4512 Section 1.4]: https://datatracker.ietf.org/doc/html/rfc4512#section-1.4
+*/
+func ClassifyOIDToken(token string) (tok OIDToken) {
+	tok.Value = token
+	switch {
+	case isLDAPNumericOID(token):
+		tok.Kind = NumericOIDToken
+	case isLDAPDescr(token):
+		tok.Kind = DescrToken
+	}
+
+	return
+}
+
+/*
+isLDAPNumericOID returns a Boolean value indicative of whether val
+satisfies the numericoid ABNF production of RFC 4512.
+*/
+func isLDAPNumericOID(val string) bool {
+	numbers := split(val, `.`)
+	if len(numbers) < 2 {
+		return false
+	}
+
+	for i := 0; i < len(numbers); i++ {
+		if !isLDAPNumber(numbers[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+isLDAPNumber returns a Boolean value indicative of whether val satisfies
+the number ABNF production of RFC 4512, which forbids leading zeros.
+*/
+func isLDAPNumber(val string) bool {
+	if len(val) == 0 {
+		return false
+	}
+
+	for i := 0; i < len(val); i++ {
+		if !isASCIIDigit(val[i]) {
+			return false
+		}
+	}
+
+	return len(val) == 1 || val[0] != '0'
+}
+
+/*
+isLDAPDescr returns a Boolean value indicative of whether val satisfies
+the descr (keystring) ABNF production of RFC 4512.
+*/
+func isLDAPDescr(val string) bool {
+	if len(val) == 0 || !isASCIIAlpha(val[0]) {
+		return false
+	}
+
+	for i := 1; i < len(val); i++ {
+		if c := val[i]; !(isASCIIAlpha(c) || isASCIIDigit(c) || c == '-') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isASCIIAlpha(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isASCIIDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+/*
+AttributeDescription contains an LDAP attribute description, composed of
+an attribute type and zero (0) or more options, per [RFC 4512 Section 2.5].
+For example, "userCertificate;binary" or "2.5.4.3;lang-de".
+
+[RFC 4512 Section 2.5]: https://datatracker.ietf.org/doc/html/rfc4512#section-2.5
+*/
+type AttributeDescription struct {
+	// Type contains the attribute type, which is either a
+	// numericoid or a descr.
+	Type OIDToken
+
+	// Options contains the attribute options, in the order
+	// in which they were encountered (e.g.: "binary").
+	Options []string
+}
+
+/*
+ParseAttributeDescription returns an instance of [AttributeDescription]
+parsed from val, alongside an error. The attribute type must satisfy the
+oid production (see [ClassifyOIDToken]), and each option must consist of
+one (1) or more keychar characters (ALPHA, DIGIT or HYPHEN).
+*/
+func ParseAttributeDescription(val string) (ad AttributeDescription, err error) {
+	parts := split(val, `;`)
+	if ad.Type = ClassifyOIDToken(parts[0]); ad.Type.Kind == InvalidToken {
+		err = errorf("Invalid attribute type '%s' in %T '%s'", parts[0], ad, val)
+		return
+	}
+
+	for i := 1; i < len(parts); i++ {
+		if !isLDAPOption(parts[i]) {
+			err = errorf("Invalid option '%s' in %T '%s'", parts[i], ad, val)
+			return
+		}
+		ad.Options = append(ad.Options, parts[i])
+	}
+
+	return
+}
+
+/*
+String returns the string representation of the receiver (e.g.:
+"userCertificate;binary").
+*/
+func (r AttributeDescription) String() (s string) {
+	if s = r.Type.Value; len(r.Options) > 0 {
+		s += `;` + join(r.Options, `;`)
+	}
+
+	return
+}
+
+/*
+HasOption returns a Boolean value indicative of whether the receiver bears
+option opt. Case is not significant.
+*/
+func (r AttributeDescription) HasOption(opt string) bool {
+	return strInSliceFold(opt, r.Options)
+}
+
+/*
+isLDAPOption returns a Boolean value indicative of whether val satisfies
+the option ABNF production of RFC 4512.
+*/
+func isLDAPOption(val string) bool {
+	if len(val) == 0 {
+		return false
+	}
+
+	for i := 0; i < len(val); i++ {
+		if c := val[i]; !(isASCIIAlpha(c) || isASCIIDigit(c) || c == '-') {
+			return false
+		}
+	}
+
+	return true
+}
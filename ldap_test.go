@@ -0,0 +1,110 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleClassifyOIDToken() {
+	for _, token := range []string{`2.5.4.3`, `cn`, `2.5.04.3`} {
+		fmt.Println(ClassifyOIDToken(token).Kind)
+	}
+	// Output:
+	// numericoid
+	// descr
+	// invalid
+}
+
+func TestClassifyOIDToken(t *testing.T) {
+	for token, want := range map[string]OIDTokenKind{
+		`2.5.4.3`:                             NumericOIDToken,
+		`1.3.6.1.4.1.56521.999`:               NumericOIDToken,
+		`3.1`:                                 NumericOIDToken,
+		`0.0`:                                 NumericOIDToken,
+		`cn`:                                  DescrToken,
+		`userCertificate`:                     DescrToken,
+		`X-ORIGIN`:                            DescrToken,
+		`a--b-`:                               DescrToken,
+		`C`:                                   DescrToken,
+		``:                                    InvalidToken,
+		`1`:                                   InvalidToken,
+		`1.`:                                  InvalidToken,
+		`.1.2`:                                InvalidToken,
+		`1..2`:                                InvalidToken,
+		`1.02`:                                InvalidToken,
+		`1-3`:                                 InvalidToken,
+		`-cn`:                                 InvalidToken,
+		`9cn`:                                 InvalidToken,
+		`c_n`:                                 InvalidToken,
+		`cn;binary`:                           InvalidToken,
+		"été":                                 InvalidToken,
+		`{iso(1) identified-organization(3)}`: InvalidToken,
+	} {
+		if got := ClassifyOIDToken(token); got.Kind != want || got.Value != token {
+			t.Errorf("%s failed for '%s': want %s, got %s", t.Name(), token, want, got.Kind)
+		}
+	}
+
+	if d, err := ClassifyOIDToken(`2.5.4.3`).Dot(); err != nil || d.String() != `2.5.4.3` {
+		t.Errorf("%s failed: bad Dot result '%s' (%v)", t.Name(), d, err)
+	}
+
+	for _, bogus := range []string{`cn`, `3.1`, `x.y`} {
+		if _, err := ClassifyOIDToken(bogus).Dot(); err == nil {
+			t.Errorf("%s failed: '%s' yielded DotNotation without error", t.Name(), bogus)
+		}
+	}
+}
+
+func ExampleParseAttributeDescription() {
+	ad, err := ParseAttributeDescription(`2.5.4.3;lang-de`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(ad.Type.Kind, ad.Type.Value, ad.Options)
+	// Output: numericoid 2.5.4.3 [lang-de]
+}
+
+func TestParseAttributeDescription(t *testing.T) {
+	for val, want := range map[string]struct {
+		kind OIDTokenKind
+		typ  string
+		opts int
+	}{
+		`cn`:                          {DescrToken, `cn`, 0},
+		`userCertificate;binary`:      {DescrToken, `userCertificate`, 1},
+		`2.5.4.3;lang-de`:             {NumericOIDToken, `2.5.4.3`, 1},
+		`description;lang-en;x-extra`: {DescrToken, `description`, 2},
+	} {
+		ad, err := ParseAttributeDescription(val)
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			continue
+		} else if ad.Type.Kind != want.kind || ad.Type.Value != want.typ || len(ad.Options) != want.opts {
+			t.Errorf("%s failed for '%s': got %#v", t.Name(), val, ad)
+		} else if ad.String() != val {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), val, ad)
+		}
+	}
+
+	ad, _ := ParseAttributeDescription(`userCertificate;binary`)
+	if !ad.HasOption(`BINARY`) || ad.HasOption(`lang-de`) {
+		t.Errorf("%s failed: bad HasOption result", t.Name())
+	}
+
+	for _, bogus := range []string{
+		``,
+		`;binary`,
+		`cn;`,
+		`cn;;binary`,
+		`cn;lang_de`,
+		`2.05.4;binary`,
+		`cn;bin ary`,
+	} {
+		if _, err := ParseAttributeDescription(bogus); err == nil {
+			t.Errorf("%s failed: bogus value '%s' parsed without error", t.Name(), bogus)
+		}
+	}
+}
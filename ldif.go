@@ -0,0 +1,171 @@
+package objectid
+
+/*
+ldif.go implements LDIF export of Registry contents per the OID Directory
+Internet-Drafts (draft-coretta-oiddir-*).
+*/
+
+import (
+	"bufio"
+	"encoding/base64"
+	"io"
+)
+
+/*
+ExportLDIF writes all records within the receiver that reside at or beneath
+subtree to w as LDIF (RFC 2849) entries suitable for import into an OID
+Directory DSA, returning an error if the operation fails.
+
+The subtree may be a string or [DotNotation]; a nil or zero string value
+results in the export of all records. Entry DNs are composed of "n" RDNs
+beneath base, in the manner described by draft-coretta-oiddir-schema. If
+base is zero, [DefaultRegistrationBase] is used.
+*/
+func (r *Registry) ExportLDIF(w io.Writer, subtree any, base string) (err error) {
+	var prefix DotNotation
+	if subtree != nil && subtree != `` {
+		D := assertDotNot(subtree)
+		if D == nil || D.Len() == 0 {
+			err = errorf("Invalid LDIF export subtree: %v", subtree)
+			return
+		}
+		prefix = *D
+	}
+
+	if len(base) == 0 {
+		base = DefaultRegistrationBase
+	}
+
+	bw := bufio.NewWriter(w)
+	if _, err = bw.WriteString("version: 1\n"); err != nil {
+		return
+	}
+
+	recs := r.Records()
+	for i := 0; i < len(recs); i++ {
+		if prefix.Len() > 0 && prefix.compare(recs[i].Dot) != 0 && !prefix.AncestorOf(recs[i].Dot) {
+			continue
+		}
+		if _, err = bw.WriteString("\n" + recs[i].ldif(base)); err != nil {
+			return
+		}
+	}
+
+	return bw.Flush()
+}
+
+/*
+ldif returns the LDIF entry representation of the receiver beneath base.
+*/
+func (r Record) ldif(base string) (entry string) {
+	oc := `arc`
+	if r.Dot.Len() == 1 {
+		oc = `rootArc`
+	}
+
+	entry += ldifLine(`dn`, r.Dot.DN(base))
+	entry += ldifLine(`objectClass`, `top`)
+	entry += ldifLine(`objectClass`, oc)
+	if len(r.Identifier) > 0 {
+		entry += ldifLine(`objectClass`, `x680Context`)
+	}
+	entry += ldifLine(`n`, r.Dot.Leaf().String())
+	entry += ldifLine(`dotNotation`, r.Dot.String())
+	if len(r.Identifier) > 0 {
+		entry += ldifLine(`identifier`, r.Identifier)
+	}
+	if len(r.Description) > 0 {
+		entry += ldifLine(`description`, r.Description)
+	}
+	if ra := r.Authority; ra != nil {
+		entry += ra.ldif()
+	}
+
+	return
+}
+
+/*
+ldif returns the LDIF attribute value lines describing the receiver. As
+the schema offers no free-form contact type, the Contact field is only
+written if it appears to be an email address.
+*/
+func (r RegistrationAuthority) ldif() (lines string) {
+	lines += ldifLine(`currentAuthorityCommonName`, r.Name)
+	if contains(r.Contact, `@`) {
+		lines += ldifLine(`currentAuthorityEmail`, r.Contact)
+	}
+	if len(r.URL) > 0 {
+		lines += ldifLine(`registrationURI`, r.URL)
+	}
+	if r.Status != StatusUnspecified {
+		lines += ldifLine(`registrationStatus`, r.Status.String())
+	}
+	if !r.Created.IsZero() {
+		lines += ldifLine(`registrationCreated`, r.Created.UTC().Format(generalizedTime))
+	}
+	if !r.Modified.IsZero() {
+		lines += ldifLine(`registrationModified`, r.Modified.UTC().Format(generalizedTime))
+	}
+
+	return
+}
+
+/*
+generalizedTime is the layout of an LDAP GeneralizedTime value in UTC,
+per RFC 4517.
+*/
+const generalizedTime = `20060102150405Z`
+
+/*
+ldifLine returns a single LDIF attribute value line, base64-encoded if
+val is not a SAFE-STRING per RFC 2849, and folded at 76 characters.
+*/
+func ldifLine(attr, val string) string {
+	line := attr + `: ` + val
+	if !isLDIFSafe(val) {
+		line = attr + `:: ` + base64.StdEncoding.EncodeToString([]byte(val))
+	}
+
+	const width = 76
+	folded := line
+	if len(line) > width {
+		folded = line[:width]
+		for rest := line[width:]; len(rest) > 0; {
+			n := width - 1
+			if n > len(rest) {
+				n = len(rest)
+			}
+			folded += "\n " + rest[:n]
+			rest = rest[n:]
+		}
+	}
+
+	return folded + "\n"
+}
+
+/*
+isLDIFSafe returns a Boolean value indicative of whether val qualifies as
+a SAFE-STRING per RFC 2849.
+*/
+func isLDIFSafe(val string) bool {
+	if len(val) == 0 {
+		return true
+	}
+
+	switch val[0] {
+	case ' ', ':', '<':
+		return false
+	}
+
+	if val[len(val)-1] == ' ' {
+		return false
+	}
+
+	for i := 0; i < len(val); i++ {
+		if c := val[i]; c == 0 || c == '\n' || c == '\r' || c > 127 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,66 @@
+package objectid
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+)
+
+func ExampleRegistry_ExportLDIF() {
+	reg := NewRegistry()
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	_ = reg.Register(Record{Dot: *dot, Identifier: `example`, Description: `Example enterprise`})
+
+	if err := reg.ExportLDIF(os.Stdout, nil, ``); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// version: 1
+	//
+	// dn: n=56521,n=1,n=4,n=1,n=6,n=3,n=1,ou=Registrations,o=rA
+	// objectClass: top
+	// objectClass: arc
+	// objectClass: x680Context
+	// n: 56521
+	// dotNotation: 1.3.6.1.4.1.56521
+	// identifier: example
+	// description: Example enterprise
+}
+
+func TestRegistry_ExportLDIF(t *testing.T) {
+	reg := newTestRegistry(t)
+	root, _ := parseArcKey(`1`)
+	_ = reg.Register(Record{Dot: root, Identifier: `iso`, Description: ` Ünïcödé`})
+
+	var buf bytes.Buffer
+	if err := reg.ExportLDIF(&buf, `1.3.6.1.4.1.56521`, `o=test`); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	out := buf.String()
+	if n := bytes.Count(buf.Bytes(), []byte("dn: ")); n != 3 {
+		t.Errorf("%s failed: want 3 entries, got %d:\n%s", t.Name(), n, out)
+	} else if !bytes.Contains(buf.Bytes(), []byte("dn: n=999,n=56521,n=1,n=4,n=1,n=6,n=3,n=1,o=test\n")) {
+		t.Errorf("%s failed: expected DN not found:\n%s", t.Name(), out)
+	}
+
+	buf.Reset()
+	if err := reg.ExportLDIF(&buf, ``, ``); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if !bytes.Contains(buf.Bytes(), []byte("objectClass: rootArc\n")) {
+		t.Errorf("%s failed: root arc not exported:\n%s", t.Name(), buf.String())
+	} else if !bytes.Contains(buf.Bytes(), []byte("description:: ")) {
+		t.Errorf("%s failed: unsafe value not base64 encoded:\n%s", t.Name(), buf.String())
+	}
+
+	if err := reg.ExportLDIF(&buf, `bogus`, ``); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+
+	long := ldifLine(`description`, string(bytes.Repeat([]byte(`x`), 200)))
+	if lines := bytes.Count([]byte(long), []byte("\n")); lines != 3 {
+		t.Errorf("%s failed: want 3 folded lines, got %d", t.Name(), lines)
+	}
+}
@@ -0,0 +1,85 @@
+package objectid
+
+/*
+lru.go implements the fixed-size, least-recently-used cache underlying
+the ParseCache and ResolverChain types.
+*/
+
+import "container/list"
+
+/*
+lruCache is a fixed-size, least-recently-used cache of values of type V
+keyed by string. Instances are not safe for concurrent use; the caller is
+responsible for synchronization.
+*/
+type lruCache[V any] struct {
+	size  int
+	order *list.List
+	items map[string]*list.Element
+}
+
+type lruEntry[V any] struct {
+	key string
+	val V
+}
+
+/*
+newLRUCache returns a new instance of *lruCache holding no more than size
+entries.
+*/
+func newLRUCache[V any](size int) *lruCache[V] {
+	return &lruCache[V]{
+		size:  size,
+		order: list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+/*
+get returns the value cached for key, alongside a Boolean value indicative
+of success. A value found becomes the most recently used.
+*/
+func (r *lruCache[V]) get(key string) (val V, found bool) {
+	var elem *list.Element
+	if elem, found = r.items[key]; found {
+		r.order.MoveToFront(elem)
+		val = elem.Value.(*lruEntry[V]).val
+	}
+
+	return
+}
+
+/*
+put caches val for key as the most recently used value, replacing any
+value previously cached for key, and evicting the least recently used
+entry if the receiver is full.
+*/
+func (r *lruCache[V]) put(key string, val V) {
+	if elem, found := r.items[key]; found {
+		elem.Value.(*lruEntry[V]).val = val
+		r.order.MoveToFront(elem)
+		return
+	}
+
+	r.items[key] = r.order.PushFront(&lruEntry[V]{key: key, val: val})
+	if r.order.Len() > r.size {
+		oldest := r.order.Back()
+		r.order.Remove(oldest)
+		delete(r.items, oldest.Value.(*lruEntry[V]).key)
+	}
+}
+
+/*
+len returns the number of entries cached by the receiver.
+*/
+func (r *lruCache[V]) len() int {
+	return r.order.Len()
+}
+
+/*
+reset removes all entries from the receiver.
+*/
+func (r *lruCache[V]) reset() {
+	r.order.Init()
+	r.items = make(map[string]*list.Element)
+}
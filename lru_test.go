@@ -0,0 +1,29 @@
+package objectid
+
+import "testing"
+
+func TestLRUCache(t *testing.T) {
+	cache := newLRUCache[int](2)
+	cache.put(`a`, 1)
+	cache.put(`b`, 2)
+
+	// Reading a promotes it, such that b is evicted next.
+	if v, found := cache.get(`a`); !found || v != 1 {
+		t.Errorf("%s failed: want 1, got %d (%t)", t.Name(), v, found)
+	}
+	cache.put(`c`, 3)
+	if _, found := cache.get(`b`); found {
+		t.Errorf("%s failed: least recently used entry not evicted", t.Name())
+	}
+
+	cache.put(`a`, 4)
+	if v, _ := cache.get(`a`); v != 4 || cache.len() != 2 {
+		t.Errorf("%s failed: want 4 of 2 entries, got %d of %d", t.Name(), v, cache.len())
+	}
+
+	if cache.reset(); cache.len() != 0 {
+		t.Errorf("%s failed: want 0 entries after reset, got %d", t.Name(), cache.len())
+	} else if _, found := cache.get(`c`); found {
+		t.Errorf("%s failed: entry survived reset", t.Name())
+	}
+}
@@ -0,0 +1,286 @@
+package objectid
+
+/*
+match.go implements the Matcher type, used to match DotNotation values
+against compiled patterns.
+*/
+
+import (
+	"math/big"
+	"sort"
+)
+
+/*
+Matcher is a compiled [DotNotation] pattern, suitable for use in access
+control and policy configurations which grant access to bounded ranges of
+arcs. Instances of this type should be created using the [NewMatcher]
+function, and are safe for concurrent use once created.
+
+Patterns are dot-delimited sequences of arc expressions, each being one of:
+
+  - a literal number (e.g.: "56521")
+  - a bracketed, comma-delimited list of numbers and inclusive ranges (e.g.: "[1-5]" or "[1,3,10-20]")
+  - a bracketed, open-ended range (e.g.: "[100-]")
+  - an asterisk ("*"), which matches any single arc
+  - a double asterisk ("**"), which matches zero (0) or more arcs of any value
+
+For example, "1.3.6.1.4.1.56521.999.[1-5]" matches 1.3.6.1.4.1.56521.999.1
+through 1.3.6.1.4.1.56521.999.5, but not 1.3.6.1.4.1.56521.999.6 nor any
+descendant of the matched values. Likewise, "1.3.6.**.5" matches 1.3.6.5,
+1.3.6.1.5 and 1.3.6.1.4.1.5, as well as any other value beginning with
+1.3.6 and ending with 5.
+
+Patterns bearing a double asterisk are compiled into a small automaton,
+such that matching remains linear in the length of the input value.
+*/
+type Matcher struct {
+	pattern string
+	steps   []matchStep
+	deep    bool
+}
+
+/*
+matchStep is a single state within a compiled [Matcher]. A deep step
+consumes zero (0) or more arcs of any value, while all other steps
+consume exactly one (1) arc which must fall within ranges.
+*/
+type matchStep struct {
+	ranges arcRanges
+	deep   bool
+}
+
+/*
+arcRanges contains the sorted, non-overlapping ranges accepted for a
+single arc. A nil hi value denotes an unbounded range.
+*/
+type arcRanges []arcRange
+
+type arcRange struct {
+	lo, hi *big.Int
+}
+
+/*
+NewMatcher returns an instance of *[Matcher] compiled from pattern,
+alongside an error. See the [Matcher] type for pattern syntax.
+*/
+func NewMatcher(pattern string) (r *Matcher, err error) {
+	pattern = trimS(pattern)
+	if len(pattern) == 0 {
+		err = errorf("Zero length %T pattern", r)
+		return
+	}
+
+	exprs := split(pattern, `.`)
+	m := &Matcher{pattern: pattern}
+
+	for i := 0; i < len(exprs); i++ {
+		if exprs[i] == `**` {
+			// Consecutive deep steps are redundant.
+			if L := len(m.steps); L == 0 || !m.steps[L-1].deep {
+				m.steps = append(m.steps, matchStep{deep: true})
+			}
+			m.deep = true
+			continue
+		}
+
+		var ranges arcRanges
+		if ranges, err = compileArc(exprs[i]); err != nil {
+			err = errorf("Invalid %T pattern '%s' at arc %d: %v", m, pattern, i, err)
+			return
+		}
+		m.steps = append(m.steps, matchStep{ranges: ranges})
+	}
+
+	if root := m.steps[0]; !root.deep && root.ranges[0].lo.Cmp(big.NewInt(2)) > 0 {
+		err = errorf("Invalid %T pattern '%s': root arc cannot exceed 2", m, pattern)
+		return
+	}
+
+	r = m
+
+	return
+}
+
+/*
+String returns the pattern from which the receiver was compiled.
+*/
+func (r Matcher) String() string {
+	return r.pattern
+}
+
+/*
+Match returns a Boolean value indicative of whether dot, which can be a
+string or [DotNotation], is matched by the receiver.
+*/
+func (r Matcher) Match(dot any) (is bool) {
+	D := assertDotNot(dot)
+	if D == nil {
+		return
+	} else if r.deep {
+		is = r.matchDeep(*D)
+		return
+	} else if D.Len() != len(r.steps) {
+		return
+	}
+
+	for i := 0; i < D.Len(); i++ {
+		if !r.steps[i].ranges.contains((*D)[i].cast()) {
+			return
+		}
+	}
+
+	is = true
+
+	return
+}
+
+/*
+matchDeep simulates the receiver's automaton against dot, tracking the
+set of active steps. An index equal to the number of steps denotes the
+accepting state.
+*/
+func (r Matcher) matchDeep(dot DotNotation) bool {
+	cur := make([]bool, len(r.steps)+1)
+	cur[0] = true
+	r.closure(cur)
+
+	for i := 0; i < dot.Len(); i++ {
+		arc := dot[i].cast()
+		next := make([]bool, len(cur))
+		var active bool
+		for s := 0; s < len(r.steps); s++ {
+			if !cur[s] {
+				continue
+			} else if r.steps[s].deep {
+				next[s], active = true, true
+			} else if r.steps[s].ranges.contains(arc) {
+				next[s+1], active = true, true
+			}
+		}
+
+		if !active {
+			return false
+		}
+		cur = next
+		r.closure(cur)
+	}
+
+	return cur[len(r.steps)]
+}
+
+/*
+closure marks as active those states reachable from any active deep
+step without consuming an arc.
+*/
+func (r Matcher) closure(states []bool) {
+	for i := 0; i < len(r.steps); i++ {
+		if states[i] && r.steps[i].deep {
+			states[i+1] = true
+		}
+	}
+}
+
+/*
+contains returns a Boolean value indicative of whether arc falls within
+any of the receiver's ranges.
+*/
+func (r arcRanges) contains(arc *big.Int) bool {
+	// Find the first range whose upper bound is not below arc.
+	idx := sort.Search(len(r), func(i int) bool {
+		return r[i].hi == nil || r[i].hi.Cmp(arc) >= 0
+	})
+
+	return idx < len(r) && r[idx].lo.Cmp(arc) <= 0
+}
+
+/*
+compileArc returns the arcRanges described by the single arc expression
+expr, alongside an error.
+*/
+func compileArc(expr string) (ranges arcRanges, err error) {
+	switch {
+	case expr == `*`:
+		ranges = arcRanges{{lo: big.NewInt(0)}}
+		return
+	case hasPrefix(expr, `[`) && hasSuffix(expr, `]`) && len(expr) > 2:
+		members := split(expr[1:len(expr)-1], `,`)
+		for i := 0; i < len(members) && err == nil; i++ {
+			var rng arcRange
+			if rng, err = compileRange(trimS(members[i])); err == nil {
+				ranges = append(ranges, rng)
+			}
+		}
+	default:
+		var n *big.Int
+		if n, err = parseMatchArc(expr); err == nil {
+			ranges = arcRanges{{lo: n, hi: n}}
+		}
+	}
+
+	if err == nil {
+		ranges = ranges.merge()
+	}
+
+	return
+}
+
+/*
+compileRange returns the arcRange described by expr, which is either a
+single number, an inclusive range ("1-5") or an open-ended range ("5-").
+*/
+func compileRange(expr string) (rng arcRange, err error) {
+	lo, hi := expr, expr
+	if idx := indexRune(expr, '-'); idx != -1 {
+		lo, hi = trimS(expr[:idx]), trimS(expr[idx+1:])
+	}
+
+	if rng.lo, err = parseMatchArc(lo); err != nil {
+		return
+	} else if len(hi) == 0 {
+		// open-ended range
+		return
+	} else if rng.hi, err = parseMatchArc(hi); err == nil && rng.lo.Cmp(rng.hi) > 0 {
+		err = errorf("Range lower bound %s exceeds upper bound %s", rng.lo, rng.hi)
+	}
+
+	return
+}
+
+func parseMatchArc(expr string) (n *big.Int, err error) {
+	if !isNumber(expr) {
+		err = errorf("Invalid arc expression '%s'", expr)
+		return
+	}
+
+	n, _ = big.NewInt(0).SetString(expr, 10)
+
+	return
+}
+
+/*
+merge returns the receiver sorted by lower bound, with overlapping and
+adjacent ranges combined.
+*/
+func (r arcRanges) merge() (merged arcRanges) {
+	sort.Slice(r, func(i, j int) bool {
+		return r[i].lo.Cmp(r[j].lo) < 0
+	})
+
+	one := big.NewInt(1)
+	for i := 0; i < len(r); i++ {
+		if L := len(merged); L > 0 {
+			last := &merged[L-1]
+			if last.hi == nil {
+				break
+			} else if next := big.NewInt(0).Add(last.hi, one); r[i].lo.Cmp(next) <= 0 {
+				if r[i].hi == nil || r[i].hi.Cmp(last.hi) > 0 {
+					last.hi = r[i].hi
+				}
+				continue
+			}
+		}
+		merged = append(merged, r[i])
+	}
+
+	return
+}
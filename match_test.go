@@ -0,0 +1,97 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleMatcher_Match() {
+	m, err := NewMatcher(`1.3.6.1.4.1.56521.999.[1-5]`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(m.Match(`1.3.6.1.4.1.56521.999.3`), m.Match(`1.3.6.1.4.1.56521.999.6`))
+	// Output: true false
+}
+
+func TestMatcher(t *testing.T) {
+	for _, tc := range []struct {
+		pattern string
+		match   []string
+		miss    []string
+	}{
+		{`1.3.6.1.4.1.56521.999.[1-5]`,
+			[]string{`1.3.6.1.4.1.56521.999.1`, `1.3.6.1.4.1.56521.999.5`},
+			[]string{`1.3.6.1.4.1.56521.999.0`, `1.3.6.1.4.1.56521.999.6`, `1.3.6.1.4.1.56521.999`, `1.3.6.1.4.1.56521.999.1.1`}},
+		{`2.999.[1,3,10-20]`,
+			[]string{`2.999.1`, `2.999.3`, `2.999.10`, `2.999.15`, `2.999.20`},
+			[]string{`2.999.2`, `2.999.9`, `2.999.21`}},
+		{`2.[100-].*`,
+			[]string{`2.100.0`, `2.999.7`, `2.1000.340282366920938463463374607431768211456`},
+			[]string{`2.99.1`, `2.100`}},
+		{`1.3.6.**.5`,
+			[]string{`1.3.6.5`, `1.3.6.1.5`, `1.3.6.1.4.1.5`, `1.3.6.5.5`},
+			[]string{`1.3.6`, `1.3.6.1`, `1.3.6.5.1`, `1.3.7.5`, `2.3.6.1.5`}},
+		{`1.3.**.**.[1-2]`,
+			[]string{`1.3.1`, `1.3.6.1.4.1.2`},
+			[]string{`1.3`, `1.3.6.3`}},
+		{`**.999.*`,
+			[]string{`2.999.1`, `1.3.6.1.4.1.56521.999.7`},
+			[]string{`2.999`, `2.999.1.1`}},
+		{`2.999.**`,
+			[]string{`2.999`, `2.999.1`, `2.999.1.2.3`},
+			[]string{`2.998`, `1.3.6`}},
+		{`*.[3-5, 4-8 ,9]`,
+			[]string{`0.3`, `1.8`, `2.9`},
+			[]string{`1.2`, `1.10`}},
+	} {
+		m, err := NewMatcher(tc.pattern)
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			continue
+		} else if m.String() != tc.pattern {
+			t.Errorf("%s failed: want pattern '%s', got '%s'", t.Name(), tc.pattern, m)
+		}
+
+		for _, dot := range tc.match {
+			if !m.Match(dot) {
+				t.Errorf("%s failed: '%s' should match '%s'", t.Name(), tc.pattern, dot)
+			}
+		}
+		for _, dot := range tc.miss {
+			if m.Match(dot) {
+				t.Errorf("%s failed: '%s' should not match '%s'", t.Name(), tc.pattern, dot)
+			}
+		}
+	}
+
+	for _, bogus := range []string{
+		``,
+		`3.1`,
+		`2..1`,
+		`2.[]`,
+		`2.[5-1]`,
+		`2.[a-b]`,
+		`2.[1-5`,
+		`2.-1`,
+		`2.***`,
+		`3.**`,
+	} {
+		if _, err := NewMatcher(bogus); err == nil {
+			t.Errorf("%s failed: bogus pattern '%s' compiled without error", t.Name(), bogus)
+		}
+	}
+}
+
+func ExampleMatcher_Match_deep() {
+	m, err := NewMatcher(`1.3.6.**.5`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(m.Match(`1.3.6.5`), m.Match(`1.3.6.1.4.1.5`), m.Match(`1.3.6.1.4.1.6`))
+	// Output: true true false
+}
@@ -1,8 +1,10 @@
 package objectid
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 	"unicode"
@@ -10,16 +12,27 @@ import (
 
 var (
 	printf     func(string, ...any) (int, error)      = fmt.Printf
+	appendUint func([]byte, uint64, int) []byte       = strconv.AppendUint
 	sprintf    func(string, ...any) string            = fmt.Sprintf
 	atoi       func(string) (int, error)              = strconv.Atoi
+	fmtUint    func(uint64, int) string               = strconv.FormatUint
 	puint64    func(string, int, int) (uint64, error) = strconv.ParseUint
 	contains   func(string, string) bool              = strings.Contains
+	count      func(string, string) int               = strings.Count
 	eq         func(string, string) bool              = strings.EqualFold
 	fields     func(string) []string                  = strings.Fields
 	hasPrefix  func(string, string) bool              = strings.HasPrefix
 	hasSuffix  func(string, string) bool              = strings.HasSuffix
+	indexAny   func(string, string) int               = strings.IndexAny
+	indexFunc  func(string, func(rune) bool) int      = strings.IndexFunc
 	indexRune  func(string, rune) int                 = strings.IndexRune
 	join       func([]string, string) string          = strings.Join
+	lc         func(string) string                    = strings.ToLower
+	escPath    func(string) string                    = url.PathEscape
+	unescPath  func(string) (string, error)           = url.PathUnescape
+	lastIndex  func(string, string) int               = strings.LastIndex
+	mapRunes   func(func(rune) rune, string) string   = strings.Map
+	repeat     func(string, int) string               = strings.Repeat
 	split      func(string, string) []string          = strings.Split
 	splitAfter func(string, string) []string          = strings.SplitAfter
 	splitN     func(string, string, int) []string     = strings.SplitN
@@ -28,6 +41,7 @@ var (
 	trimR      func(string, string) string            = strings.TrimRight
 	isDigit    func(rune) bool                        = unicode.IsDigit
 	isLetter   func(rune) bool                        = unicode.IsLetter
+	isSpace    func(rune) bool                        = unicode.IsSpace
 	isLower    func(rune) bool                        = unicode.IsLower
 	isUpper    func(rune) bool                        = unicode.IsUpper
 )
@@ -221,3 +235,16 @@ func condenseWHSP(b string) (a string) {
 
 	return
 }
+
+/*
+canceled returns the error of ctx if ctx is done, or nil otherwise. It
+never blocks, and is intended for periodic checks within long loops.
+*/
+func canceled(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+		return nil
+	}
+}
@@ -70,6 +70,33 @@ func (r NameAndNumberForm) Equal(n any) (is bool) {
 	return
 }
 
+/*
+Validate returns an error describing the first rule violated by the
+receiver, or nil if no violation is found. The following conditions
+are reported:
+
+  - The receiver was not produced by a constructor
+  - The [NumberForm] is negative
+  - A non-zero identifier does not satisfy the applicable syntax
+
+Identifiers are judged per ITU-T Rec. X.680 (see [IsIdentifier]), unless
+the [LDAPIdentifiers] option is provided. This method is useful to check
+instances, such as those built from uint or [NumberForm] values, before
+placing them into an [ASN1Notation] or [OID].
+*/
+func (r NameAndNumberForm) Validate(opts ...ParseOption) (err error) {
+	cfg := newParseConfig(opts...)
+	if !r.parsed {
+		err = errorf("%T was not properly initialized", r)
+	} else if r.primaryIdentifier.cast().Sign() < 0 {
+		err = errorf("%T bears a negative NumberForm (%s)", r, r.primaryIdentifier)
+	} else if len(r.identifier) > 0 {
+		err = cfg.checkIdentifier(r.identifier)
+	}
+
+	return
+}
+
 func parseRootNameOnly(x string) (r *NameAndNumberForm, err error) {
 	var root *big.Int
 	switch x {
@@ -163,7 +190,7 @@ func parseNaNFOrNF(tv string) (r *NameAndNumberForm, err error) {
 func parseNaNFBig(tv *big.Int) (r *NameAndNumberForm, err error) {
 	r = new(NameAndNumberForm)
 
-	if tv.Int64() < 0 {
+	if tv.Sign() < 0 {
 		err = errorf("NameAndNumberForm cannot contain a negative NumberForm")
 		return
 	}
@@ -173,7 +200,7 @@ func parseNaNFBig(tv *big.Int) (r *NameAndNumberForm, err error) {
 		return
 	}
 
-	r.primaryIdentifier = NumberForm(*tv)
+	r.primaryIdentifier = NumberForm(*new(big.Int).Set(tv))
 
 	return
 }
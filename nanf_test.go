@@ -141,3 +141,45 @@ func TestParseNaNFStr(t *testing.T) {
 		}
 	}
 }
+
+func ExampleNameAndNumberForm_Validate() {
+	nanf, _ := NewNameAndNumberForm(`enterprise(1)`)
+	fmt.Println(nanf.Validate())
+	// Output: <nil>
+}
+
+func TestNameAndNumberForm_Validate(t *testing.T) {
+	for _, x := range []any{`enterprise(1)`, `56521`, uint(3), uint64(7), 4, `iso`} {
+		nanf, err := NewNameAndNumberForm(x)
+		if err != nil {
+			t.Fatalf("%s failed: %v", t.Name(), err)
+		} else if err = nanf.Validate(); err != nil {
+			t.Errorf("%s failed for %v: %v", t.Name(), x, err)
+		} else if err = nanf.Validate(LDAPIdentifiers()); err != nil {
+			t.Errorf("%s failed for %v (LDAP): %v", t.Name(), x, err)
+		}
+	}
+
+	var zero NameAndNumberForm
+	if err := zero.Validate(); err == nil {
+		t.Errorf("%s failed: zero instance passed validation", t.Name())
+	}
+
+	// Identifiers which satisfy only one rule set.
+	ldapOnly := NameAndNumberForm{identifier: `Enterprise--x`, parsed: true}
+	if err := ldapOnly.Validate(); err == nil {
+		t.Errorf("%s failed: X.680 accepted '%s'", t.Name(), ldapOnly.identifier)
+	} else if err = ldapOnly.Validate(LDAPIdentifiers()); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	}
+
+	bad := NameAndNumberForm{identifier: `bad_name`, parsed: true}
+	if err := bad.Validate(LDAPIdentifiers()); err == nil {
+		t.Errorf("%s failed: LDAP accepted '%s'", t.Name(), bad.identifier)
+	}
+
+	neg := NameAndNumberForm{primaryIdentifier: NumberForm(*big.NewInt(-1)), parsed: true}
+	if err := neg.Validate(); err == nil {
+		t.Errorf("%s failed: negative NumberForm passed validation", t.Name())
+	}
+}
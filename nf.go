@@ -21,11 +21,25 @@ func (r *NumberForm) IsZero() (is bool) {
 	return len(r.cast().Bytes()) == 0
 }
 
+/*
+cast returns a *[math/big.Int] view of the receiver. The view shares the
+receiver's underlying words, and MUST be treated as read-only: it may be
+used as an operand, but never as the destination of an arithmetic method.
+Use [NumberForm.clone] where a mutable instance is required.
+*/
 func (r NumberForm) cast() *big.Int {
 	x := big.Int(r)
 	return &x
 }
 
+/*
+clone returns a *[math/big.Int] deep copy of the receiver, which may be
+freely mutated without affecting the receiver or its copies.
+*/
+func (r NumberForm) clone() *big.Int {
+	return new(big.Int).Set(r.cast())
+}
+
 /*
 Equal returns a boolean value indicative of whether the receiver is equal to
 the value provided.
@@ -171,13 +185,146 @@ func (r NumberForm) String() string {
 	return r.cast().String()
 }
 
-func newStringNF(tv string) (nf *big.Int, err error) {
+/*
+AppendText appends the base-10 string representation of the receiver to
+b, returning the extended buffer alongside an error. This satisfies the
+[encoding.TextAppender] interface.
+*/
+func (r NumberForm) AppendText(b []byte) ([]byte, error) {
+	return r.AppendDecimal(b), nil
+}
+
+/*
+MarshalText implements the [encoding.TextMarshaler] interface, returning
+the base-10 string representation of the receiver. This allows values of
+any magnitude, such as the 128-bit arcs of UUID-based OIDs, to be used
+within JSON, YAML and similar documents as strings, thereby avoiding the
+lossy conversion of large values to floating point numbers.
+*/
+func (r NumberForm) MarshalText() ([]byte, error) {
+	return r.AppendDecimal(nil), nil
+}
+
+/*
+UnmarshalText implements the [encoding.TextUnmarshaler] interface, setting
+the receiver to the value of the base-10 string text, as read by
+[NewNumberForm] with default options. The receiver is left unmodified
+upon error.
+*/
+func (r *NumberForm) UnmarshalText(text []byte) (err error) {
+	var nf *big.Int
+	if nf, err = newStringNF(string(text), newParseConfig()); err == nil {
+		*r = NumberForm(*nf)
+	}
+
+	return
+}
+
+/*
+AppendDecimal appends the base-10 string representation of the receiver to
+b, returning the extended buffer. Unlike [NumberForm.AppendText], no error
+is returned, allowing callers to render many arcs into a single buffer
+without intermediate allocations. Arcs which fit within a uint64 are
+rendered without use of [math/big.Int] formatting.
+*/
+func (r NumberForm) AppendDecimal(b []byte) []byte {
+	if x := r.cast(); x.IsUint64() {
+		return appendUint(b, x.Uint64(), 10)
+	}
+
+	return r.cast().Append(b, 10)
+}
+
+/*
+AppendBinary appends the receiver to b as an ASN.1 subidentifier, which
+is to say in base-128 with the high bit of all octets but the last set,
+per ITU-T Rec. X.690 clause 8.19.2. The extended buffer is returned
+alongside an error. This satisfies the [encoding.BinaryAppender] interface.
+*/
+func (r NumberForm) AppendBinary(b []byte) ([]byte, error) {
+	return append(b, encodeVLQ(r.cast().Bytes())...), nil
+}
+
+/*
+Lsh returns a new instance of [NumberForm] bearing the value of the
+receiver shifted left by n bits. The receiver is not modified.
+
+This method, along with [NumberForm.Rsh], [NumberForm.Or] and
+[NumberForm.AndMask], allows arcs to be assembled from component fields,
+such as the time, clock sequence and node fields of a UUID per ITU-T
+Rec. X.667, for use beneath the 2.25 arc.
+*/
+func (r NumberForm) Lsh(n uint) NumberForm {
+	return NumberForm(*big.NewInt(0).Lsh(r.cast(), n))
+}
+
+/*
+Rsh returns a new instance of [NumberForm] bearing the value of the
+receiver shifted right by n bits. The receiver is not modified.
+*/
+func (r NumberForm) Rsh(n uint) NumberForm {
+	return NumberForm(*big.NewInt(0).Rsh(r.cast(), n))
+}
+
+/*
+Or returns a new instance of [NumberForm] bearing the bitwise OR of the
+receiver and n. Neither the receiver nor n are modified.
+*/
+func (r NumberForm) Or(n NumberForm) NumberForm {
+	return NumberForm(*big.NewInt(0).Or(r.cast(), n.cast()))
+}
+
+/*
+AndMask returns a new instance of [NumberForm] bearing only the lowest
+bits bits of the receiver, with all higher bits cleared. For example, an
+AndMask of 48 extracts the node field of a UUID. The receiver is not
+modified.
+*/
+func (r NumberForm) AndMask(bits uint) NumberForm {
+	mask := big.NewInt(1)
+	mask.Lsh(mask, bits).Sub(mask, big.NewInt(1))
+
+	return NumberForm(*mask.And(r.cast(), mask))
+}
+
+/*
+Uint128 returns the receiver as a pair of uint64 values, hi and lo, which
+together form a 128-bit unsigned integer, alongside a Boolean value which
+is false if the receiver exceeds 128 bits. This is suitable for storage
+of arcs, such as those beneath 2.25, in two (2) BIGINT columns or fixed
+sixteen (16) byte keys. See also [NewNumberFormUint128].
+*/
+func (r NumberForm) Uint128() (hi, lo uint64, ok bool) {
+	x := r.cast()
+	if ok = x.Sign() >= 0 && x.BitLen() <= 128; ok {
+		lo = big.NewInt(0).And(x, new(big.Int).SetUint64(^uint64(0))).Uint64()
+		hi = big.NewInt(0).Rsh(x, 64).Uint64()
+	}
+
+	return
+}
+
+/*
+NewNumberFormUint128 returns an instance of [NumberForm] whose value is
+the 128-bit unsigned integer formed from hi and lo. This is the inverse
+of the [NumberForm.Uint128] method.
+*/
+func NewNumberFormUint128(hi, lo uint64) NumberForm {
+	x := new(big.Int).SetUint64(hi)
+	x.Lsh(x, 64).Or(x, new(big.Int).SetUint64(lo))
+
+	return NumberForm(*x)
+}
+
+func newStringNF(tv string, cfg *parseConfig) (nf *big.Int, err error) {
 	if len(tv) == 0 {
 		err = errorf("Zero length NumberForm %T", tv)
 		return
 	} else if tv[0] == '-' {
 		err = errorf("A NumberForm cannot be negative")
 		return
+	} else if err = cfg.checkArc(tv); err != nil {
+		return
 	}
 
 	var ok bool
@@ -192,17 +339,30 @@ func newStringNF(tv string) (nf *big.Int, err error) {
 NewNumberForm converts v into an instance of [NumberForm], which is
 returned alongside an error.
 
-Valid input types are string, uint64, int, uint, and *[math/big.Int].
+Valid input types are string, uint64, int, uint, *[math/big.Int] and [NumberForm].
 
 Any input that represents a negative or unspecified number guarantees an error.
+
+Zero or more instances of [ParseOption] may be provided to alter the parsing
+of string input. By default, leading zeros are silently discarded, such that
+"007" yields 7. Use [RejectLeadingZeros] in canonicalization-sensitive
+contexts where such ambiguous spellings must not be accepted.
 */
-func NewNumberForm(v any) (r NumberForm, err error) {
+func NewNumberForm(v any, opts ...ParseOption) (r NumberForm, err error) {
+	return newNumberForm(v, newParseConfig(opts...))
+}
+
+func newNumberForm(v any, cfg *parseConfig) (r NumberForm, err error) {
 	switch tv := v.(type) {
 	case *big.Int:
-		r = NumberForm(*tv)
+		// copy tv, lest the caller's subsequent use
+		// of it alter the resulting NumberForm.
+		r = NumberForm(*new(big.Int).Set(tv))
+	case NumberForm:
+		r = tv
 	case string:
 		var _a *big.Int
-		if _a, err = newStringNF(tv); err == nil {
+		if _a, err = newStringNF(tv, cfg); err == nil {
 			r = NumberForm(*_a)
 		}
 	case int:
@@ -1,6 +1,7 @@
 package objectid
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"testing"
@@ -245,3 +246,212 @@ func ExampleNumberForm_Lt_byUint64() {
 	fmt.Printf("%s < %d: %t", nf, oth, nf.Lt(oth))
 	// Output: 4658 < 4501: false
 }
+
+func ExampleNewNumberForm_rejectLeadingZeros() {
+	_, err := NewNumberForm(`007`, RejectLeadingZeros())
+	fmt.Println(err)
+	// Output: Arc '007' bears leading zeros
+}
+
+func TestNewNumberForm_leadingZeros(t *testing.T) {
+	nf, err := NewNumberForm(`007`)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if nf.String() != `7` {
+		t.Errorf("%s failed: want '7', got '%s'", t.Name(), nf)
+	}
+
+	for _, valid := range []string{`0`, `7`, `700`} {
+		if _, err = NewNumberForm(valid, RejectLeadingZeros()); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		}
+	}
+
+	for _, bogus := range []string{`00`, `007`} {
+		if _, err = NewNumberForm(bogus, RejectLeadingZeros()); err == nil {
+			t.Errorf("%s failed: bogus '%s' parsed without error", t.Name(), bogus)
+		}
+	}
+}
+
+/*
+This example demonstrates the assembly of a 2.25 arc from the fields of
+the UUID f81d4fae-7dec-11d0-a765-00a0c91e6bf6, as described in ITU-T Rec.
+X.667.
+*/
+func ExampleNumberForm_Lsh() {
+	timeFields, _ := NewNumberForm(uint64(0xf81d4fae7dec11d0))
+	clockSeq, _ := NewNumberForm(uint64(0xa765))
+	node, _ := NewNumberForm(uint64(0x00a0c91e6bf6))
+
+	arc := timeFields.Lsh(64).Or(clockSeq.Lsh(48)).Or(node)
+	fmt.Println(arc)
+	// Output: 329800735698586629295641978511506172918
+}
+
+func TestNumberForm_bits(t *testing.T) {
+	nf, _ := NewNumberForm(`329800735698586629295641978511506172918`)
+
+	if got := nf.AndMask(48); !got.Equal(uint64(0x00a0c91e6bf6)) {
+		t.Errorf("%s failed: bad node field %s", t.Name(), got)
+	} else if got = nf.Rsh(48).AndMask(16); !got.Equal(uint64(0xa765)) {
+		t.Errorf("%s failed: bad clock sequence %s", t.Name(), got)
+	} else if got = nf.Rsh(64); !got.Equal(uint64(0xf81d4fae7dec11d0)) {
+		t.Errorf("%s failed: bad time fields %s", t.Name(), got)
+	} else if got = nf.AndMask(0); !got.Equal(0) {
+		t.Errorf("%s failed: zero mask yielded %s", t.Name(), got)
+	} else if got = nf.Lsh(0); !got.Equal(nf) {
+		t.Errorf("%s failed: zero shift yielded %s", t.Name(), got)
+	}
+
+	// Verify the receiver was never modified.
+	if nf.String() != `329800735698586629295641978511506172918` {
+		t.Errorf("%s failed: receiver modified: %s", t.Name(), nf)
+	}
+}
+
+func ExampleNumberForm_Uint128() {
+	nf, _ := NewNumberForm(`329800735698586629295641978511506172918`)
+	hi, lo, ok := nf.Uint128()
+	fmt.Printf("%#x %#x %t", hi, lo, ok)
+	// Output: 0xf81d4fae7dec11d0 0xa76500a0c91e6bf6 true
+}
+
+func TestNumberForm_Uint128(t *testing.T) {
+	for val, want := range map[string][2]uint64{
+		`0`:                    {0, 0},
+		`18446744073709551615`: {0, ^uint64(0)},
+		`18446744073709551616`: {1, 0},
+		`340282366920938463463374607431768211455`: {^uint64(0), ^uint64(0)},
+	} {
+		nf, _ := NewNumberForm(val)
+		if hi, lo, ok := nf.Uint128(); !ok || hi != want[0] || lo != want[1] {
+			t.Errorf("%s failed for %s: got %#x/%#x (%t)", t.Name(), val, hi, lo, ok)
+		}
+	}
+
+	nf, _ := NewNumberForm(`340282366920938463463374607431768211456`)
+	if hi, lo, ok := nf.Uint128(); ok || hi != 0 || lo != 0 {
+		t.Errorf("%s failed: 129-bit value accepted", t.Name())
+	}
+}
+
+func ExampleNewNumberFormUint128() {
+	nf := NewNumberFormUint128(0xf81d4fae7dec11d0, 0xa76500a0c91e6bf6)
+	fmt.Println(nf)
+	// Output: 329800735698586629295641978511506172918
+}
+
+func TestNewNumberFormUint128(t *testing.T) {
+	for _, pair := range [][2]uint64{
+		{0, 0},
+		{0, 1},
+		{1, 0},
+		{^uint64(0), ^uint64(0)},
+		{0xf81d4fae7dec11d0, 0xa76500a0c91e6bf6},
+	} {
+		nf := NewNumberFormUint128(pair[0], pair[1])
+		if hi, lo, ok := nf.Uint128(); !ok || hi != pair[0] || lo != pair[1] {
+			t.Errorf("%s failed: want %#x/%#x, got %#x/%#x", t.Name(), pair[0], pair[1], hi, lo)
+		}
+	}
+}
+
+func TestNumberForm_noAliasing(t *testing.T) {
+	x, _ := big.NewInt(0).SetString(`987895962269883002155146617097157934`, 10)
+	nf, _ := NewNumberForm(x)
+	nanf, _ := NewNameAndNumberForm(x)
+
+	x.Add(x, big.NewInt(1))
+	if want := `987895962269883002155146617097157934`; nf.String() != want || nanf.NumberForm().String() != want {
+		t.Errorf("%s failed: mutation of input altered NumberForm: %s, %s",
+			t.Name(), nf, nanf.NumberForm())
+	}
+
+	c := nf.clone()
+	c.Mul(c, big.NewInt(40))
+	if !nf.Equal(`987895962269883002155146617097157934`) {
+		t.Errorf("%s failed: mutation of clone altered NumberForm: %s", t.Name(), nf)
+	}
+}
+
+func TestNumberForm_Appenders(t *testing.T) {
+	for _, tc := range []struct {
+		in   any
+		text string
+		bin  []byte
+	}{
+		{0, `0`, []byte{0x00}},
+		{127, `127`, []byte{0x7f}},
+		{56521, `56521`, []byte{0x83, 0xb9, 0x49}},
+		{`987895962269883002155146617097157934`, `987895962269883002155146617097157934`, nil},
+	} {
+		nf, _ := NewNumberForm(tc.in)
+		if b, err := nf.AppendText([]byte(`:`)); err != nil || string(b) != `:`+tc.text {
+			t.Errorf("%s failed: want ':%s', got '%s'", t.Name(), tc.text, b)
+		} else if b = nf.AppendDecimal([]byte(`:`)); string(b) != `:`+tc.text {
+			t.Errorf("%s failed: want ':%s', got '%s'", t.Name(), tc.text, b)
+		}
+
+		if tc.bin == nil {
+			continue
+		} else if b, err := nf.AppendBinary([]byte{0xff}); err != nil || string(b) != string(append([]byte{0xff}, tc.bin...)) {
+			t.Errorf("%s failed: want %#v, got %#v", t.Name(), tc.bin, b)
+		}
+	}
+}
+
+func TestNumberForm_AppendDecimal_allocs(t *testing.T) {
+	dot := mustDot(`1.3.6.1.4.1.56521.999.18446744073709551615`)
+	buf := make([]byte, 0, 64)
+	if n := testing.AllocsPerRun(100, func() {
+		buf = buf[:0]
+		for i := 0; i < dot.Len(); i++ {
+			buf = dot[i].AppendDecimal(append(buf, '.'))
+		}
+	}); n > 0 {
+		t.Errorf("%s failed: want 0 allocations, got %.1f", t.Name(), n)
+	}
+
+	// DotNotation.String allocates its buffer and result only.
+	if n := testing.AllocsPerRun(100, func() { _ = dot.String() }); n > 2 {
+		t.Errorf("%s failed: want at most 2 allocations, got %.1f", t.Name(), n)
+	}
+}
+
+func ExampleNumberForm_MarshalText() {
+	type arc struct {
+		Number NumberForm `json:"number"`
+	}
+
+	nf, _ := NewNumberForm(`987895962269883002155146617097157934`)
+	b, _ := json.Marshal(arc{Number: nf})
+	fmt.Println(string(b))
+	// Output: {"number":"987895962269883002155146617097157934"}
+}
+
+func TestNumberForm_UnmarshalText(t *testing.T) {
+	var got struct {
+		Arcs []NumberForm `json:"arcs"`
+	}
+
+	const raw = `{"arcs":["2","25","987895962269883002155146617097157934"]}`
+	if err := json.Unmarshal([]byte(raw), &got); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if dot := DotNotation(got.Arcs); dot.String() != `2.25.987895962269883002155146617097157934` {
+		t.Errorf("%s failed: unexpected arcs %s", t.Name(), dot)
+	}
+
+	if b, err := json.Marshal(got); err != nil || string(b) != raw {
+		t.Errorf("%s failed: want %s, got %s (err: %v)", t.Name(), raw, b, err)
+	}
+
+	nf, _ := NewNumberForm(7)
+	for _, bad := range []string{``, `-1`, `12a`, ` 1`} {
+		if err := nf.UnmarshalText([]byte(bad)); err == nil {
+			t.Errorf("%s failed: expected error for '%s'", t.Name(), bad)
+		} else if !nf.Equal(7) {
+			t.Errorf("%s failed: receiver modified upon error", t.Name())
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package objectid
+
+/*
+objname.go contains the object name table, which associates well-known
+arcs with short and long names in the manner of OpenSSL's objects table.
+*/
+
+import "sync"
+
+/*
+ObjectName contains the short name (SN) and long name (LN) of an arc, as
+used by OpenSSL and compatible certificate tooling (e.g.: "CN" and
+"commonName" for 2.5.4.3).
+*/
+type ObjectName struct {
+	Short string
+	Long  string
+}
+
+/*
+objectNames contains the known short and long names for individual arcs,
+keyed by the dot notation of the arc. The byShort and byLong maps index
+each arc by its respective names, which are case-sensitive.
+*/
+var objectNames = struct {
+	sync.RWMutex
+	byArc   map[string]ObjectName
+	byShort map[string]string
+	byLong  map[string]string
+}{
+	byArc: map[string]ObjectName{
+		`0.9.2342.19200300.100.1.1`:  {`UID`, `userId`},
+		`0.9.2342.19200300.100.1.25`: {`DC`, `domainComponent`},
+		`1.2.840.10045.2.1`:          {`id-ecPublicKey`, `id-ecPublicKey`},
+		`1.2.840.10045.3.1.7`:        {`prime256v1`, `prime256v1`},
+		`1.2.840.10045.4.3.2`:        {`ecdsa-with-SHA256`, `ecdsa-with-SHA256`},
+		`1.2.840.10045.4.3.3`:        {`ecdsa-with-SHA384`, `ecdsa-with-SHA384`},
+		`1.2.840.10045.4.3.4`:        {`ecdsa-with-SHA512`, `ecdsa-with-SHA512`},
+		`1.2.840.113549.1.1.1`:       {`rsaEncryption`, `rsaEncryption`},
+		`1.2.840.113549.1.1.5`:       {`RSA-SHA1`, `sha1WithRSAEncryption`},
+		`1.2.840.113549.1.1.10`:      {`RSASSA-PSS`, `rsassaPss`},
+		`1.2.840.113549.1.1.11`:      {`RSA-SHA256`, `sha256WithRSAEncryption`},
+		`1.2.840.113549.1.1.12`:      {`RSA-SHA384`, `sha384WithRSAEncryption`},
+		`1.2.840.113549.1.1.13`:      {`RSA-SHA512`, `sha512WithRSAEncryption`},
+		`1.2.840.113549.1.9.1`:       {`emailAddress`, `emailAddress`},
+		`1.2.840.113549.2.5`:         {`MD5`, `md5`},
+		`1.3.14.3.2.26`:              {`SHA1`, `sha1`},
+		`1.3.101.110`:                {`X25519`, `X25519`},
+		`1.3.101.112`:                {`ED25519`, `ED25519`},
+		`1.3.132.0.34`:               {`secp384r1`, `secp384r1`},
+		`1.3.132.0.35`:               {`secp521r1`, `secp521r1`},
+		`1.3.6.1.5.5.7.1.1`:          {`authorityInfoAccess`, `Authority Information Access`},
+		`1.3.6.1.5.5.7.3.1`:          {`serverAuth`, `TLS Web Server Authentication`},
+		`1.3.6.1.5.5.7.3.2`:          {`clientAuth`, `TLS Web Client Authentication`},
+		`1.3.6.1.5.5.7.3.3`:          {`codeSigning`, `Code Signing`},
+		`1.3.6.1.5.5.7.3.4`:          {`emailProtection`, `E-mail Protection`},
+		`1.3.6.1.5.5.7.3.8`:          {`timeStamping`, `Time Stamping`},
+		`1.3.6.1.5.5.7.3.9`:          {`OCSPSigning`, `OCSP Signing`},
+		`1.3.6.1.5.5.7.48.1`:         {`OCSP`, `OCSP`},
+		`1.3.6.1.5.5.7.48.2`:         {`caIssuers`, `CA Issuers`},
+		`2.5.4.3`:                    {`CN`, `commonName`},
+		`2.5.4.4`:                    {`SN`, `surname`},
+		`2.5.4.5`:                    {`serialNumber`, `serialNumber`},
+		`2.5.4.6`:                    {`C`, `countryName`},
+		`2.5.4.7`:                    {`L`, `localityName`},
+		`2.5.4.8`:                    {`ST`, `stateOrProvinceName`},
+		`2.5.4.9`:                    {`street`, `streetAddress`},
+		`2.5.4.10`:                   {`O`, `organizationName`},
+		`2.5.4.11`:                   {`OU`, `organizationalUnitName`},
+		`2.5.4.12`:                   {`title`, `title`},
+		`2.5.4.42`:                   {`GN`, `givenName`},
+		`2.5.4.43`:                   {`initials`, `initials`},
+		`2.5.4.46`:                   {`dnQualifier`, `dnQualifier`},
+		`2.5.4.65`:                   {`pseudonym`, `pseudonym`},
+		`2.5.29.14`:                  {`subjectKeyIdentifier`, `X509v3 Subject Key Identifier`},
+		`2.5.29.15`:                  {`keyUsage`, `X509v3 Key Usage`},
+		`2.5.29.17`:                  {`subjectAltName`, `X509v3 Subject Alternative Name`},
+		`2.5.29.18`:                  {`issuerAltName`, `X509v3 Issuer Alternative Name`},
+		`2.5.29.19`:                  {`basicConstraints`, `X509v3 Basic Constraints`},
+		`2.5.29.30`:                  {`nameConstraints`, `X509v3 Name Constraints`},
+		`2.5.29.31`:                  {`crlDistributionPoints`, `X509v3 CRL Distribution Points`},
+		`2.5.29.32`:                  {`certificatePolicies`, `X509v3 Certificate Policies`},
+		`2.5.29.35`:                  {`authorityKeyIdentifier`, `X509v3 Authority Key Identifier`},
+		`2.5.29.37`:                  {`extendedKeyUsage`, `X509v3 Extended Key Usage`},
+		`2.16.840.1.101.3.4.2.1`:     {`SHA256`, `sha256`},
+		`2.16.840.1.101.3.4.2.2`:     {`SHA384`, `sha384`},
+		`2.16.840.1.101.3.4.2.3`:     {`SHA512`, `sha512`},
+	},
+	byShort: make(map[string]string),
+	byLong:  make(map[string]string),
+}
+
+func init() {
+	for key, name := range objectNames.byArc {
+		objectNames.byShort[name.Short] = key
+		objectNames.byLong[name.Long] = key
+	}
+}
+
+/*
+RegisterObjectName assigns the short name sn and long name ln to the arc
+identified by dot, which can be a string or [DotNotation], within the
+package-wide object name table. Any names previously assigned to the arc
+are replaced.
+
+An error is returned if dot is invalid, if either name is zero length, or
+if either name is already assigned to a different arc. As with OpenSSL,
+names are case-sensitive.
+*/
+func RegisterObjectName(dot any, sn, ln string) (err error) {
+	key, ok := arcKey(dot)
+	if !ok {
+		err = errorf("Invalid arc for object name registration: %v", dot)
+		return
+	} else if len(sn) == 0 || len(ln) == 0 {
+		err = errorf("Short and long names are required for %s", key)
+		return
+	}
+
+	objectNames.Lock()
+	defer objectNames.Unlock()
+
+	if k, found := objectNames.byShort[sn]; found && k != key {
+		err = errorf("Short name '%s' already assigned to %s", sn, k)
+		return
+	} else if k, found = objectNames.byLong[ln]; found && k != key {
+		err = errorf("Long name '%s' already assigned to %s", ln, k)
+		return
+	}
+
+	if old, found := objectNames.byArc[key]; found {
+		delete(objectNames.byShort, old.Short)
+		delete(objectNames.byLong, old.Long)
+	}
+
+	objectNames.byArc[key] = ObjectName{Short: sn, Long: ln}
+	objectNames.byShort[sn] = key
+	objectNames.byLong[ln] = key
+
+	return
+}
+
+/*
+LookupObjectName returns the [ObjectName] assigned to the arc identified
+by dot, which can be a string or [DotNotation], alongside a Boolean value
+indicative of a successful lookup.
+*/
+func LookupObjectName(dot any) (name ObjectName, found bool) {
+	if key, ok := arcKey(dot); ok {
+		objectNames.RLock()
+		defer objectNames.RUnlock()
+		name, found = objectNames.byArc[key]
+	}
+
+	return
+}
+
+/*
+LookupShortName returns the [DotNotation] of the arc bearing the short
+name sn (e.g.: "CN"), alongside a Boolean value indicative of a successful
+lookup.
+*/
+func LookupShortName(sn string) (DotNotation, bool) {
+	objectNames.RLock()
+	key, found := objectNames.byShort[sn]
+	objectNames.RUnlock()
+
+	return objectNameDot(key, found)
+}
+
+/*
+LookupLongName returns the [DotNotation] of the arc bearing the long name
+ln (e.g.: "commonName"), alongside a Boolean value indicative of a
+successful lookup.
+*/
+func LookupLongName(ln string) (DotNotation, bool) {
+	objectNames.RLock()
+	key, found := objectNames.byLong[ln]
+	objectNames.RUnlock()
+
+	return objectNameDot(key, found)
+}
+
+func objectNameDot(key string, found bool) (d DotNotation, ok bool) {
+	if found {
+		d, ok = parseArcKey(key)
+	}
+
+	return
+}
@@ -0,0 +1,53 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleLookupShortName() {
+	dot, _ := LookupShortName(`CN`)
+	name, _ := LookupObjectName(dot)
+	fmt.Println(dot, name.Long)
+	// Output: 2.5.4.3 commonName
+}
+
+func TestObjectNames(t *testing.T) {
+	objectNames.RLock()
+	arcs, shorts, longs := len(objectNames.byArc), len(objectNames.byShort), len(objectNames.byLong)
+	objectNames.RUnlock()
+	if arcs != shorts || arcs != longs {
+		t.Fatalf("%s failed: ambiguous names in table (%d arcs, %d SN, %d LN)", t.Name(), arcs, shorts, longs)
+	}
+
+	if dot, ok := LookupLongName(`TLS Web Server Authentication`); !ok || dot.String() != `1.3.6.1.5.5.7.3.1` {
+		t.Errorf("%s failed: bad long name lookup %s", t.Name(), dot)
+	} else if _, ok = LookupShortName(`cn`); ok {
+		t.Errorf("%s failed: short names should be case-sensitive", t.Name())
+	}
+
+	if err := RegisterObjectName(`1.3.6.1.4.1.56521.1`, `exampleAttr`, `Example Attribute`); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if err = RegisterObjectName(`1.3.6.1.4.1.56521.1`, `exAttr`, `Example Attribute`); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if _, ok := LookupShortName(`exampleAttr`); ok {
+		t.Errorf("%s failed: replaced short name still resolves", t.Name())
+	} else if dot, ok := LookupShortName(`exAttr`); !ok || dot.String() != `1.3.6.1.4.1.56521.1` {
+		t.Errorf("%s failed: bad lookup of replaced short name", t.Name())
+	}
+
+	for _, bogus := range [][3]string{
+		{`bogus`, `x`, `y`},
+		{`2.999.1`, ``, `y`},
+		{`2.999.1`, `CN`, `Something`},
+		{`2.999.1`, `something`, `commonName`},
+	} {
+		if err := RegisterObjectName(bogus[0], bogus[1], bogus[2]); err == nil {
+			t.Errorf("%s failed: no error for %v", t.Name(), bogus)
+		}
+	}
+
+	if _, ok := LookupObjectName(`bogus`); ok {
+		t.Errorf("%s failed: bogus lookup succeeded", t.Name())
+	}
+}
@@ -40,15 +40,45 @@ func (r OID) Dot() (d DotNotation) {
 }
 
 /*
-ASN returns the underlying [ASN1Notation] instance found within the receiver.
+ASN returns a copy of the underlying [ASN1Notation] instance found within
+the receiver.
 */
 func (r OID) ASN() (a ASN1Notation) {
 	if !r.IsZero() {
-		a = r.nanf
+		a = make(ASN1Notation, len(r.nanf))
+		copy(a, r.nanf)
 	}
 	return
 }
 
+/*
+Strings returns both the [ASN1Notation] and [DotNotation] string forms of
+the receiver in a single pass (e.g.: "{joint-iso-itu-t(2) example(999)}"
+and "2.999"). This is useful when logging or persisting annotated values.
+
+As with the [OID.Dot] method, a zero dot string is returned if the receiver
+contains fewer than two (2) arcs.
+*/
+func (r OID) Strings() (asn, dot string) {
+	if r.IsZero() {
+		return
+	}
+
+	a := make([]string, len(r.nanf))
+	d := make([]string, len(r.nanf))
+	for i := 0; i < len(r.nanf); i++ {
+		a[i] = r.nanf[i].String()
+		d[i] = r.nanf[i].NumberForm().String()
+	}
+
+	asn = `{` + join(a, ` `) + `}`
+	if len(d) >= 2 {
+		dot = join(d, `.`)
+	}
+
+	return
+}
+
 /*
 Valid returns a Boolean value indicative of whether the receiver's state is considered value.
 */
@@ -80,6 +110,30 @@ func (r OID) Len() (i int) {
 	return
 }
 
+/*
+Depth returns the number of arcs present within the receiver. This is
+synonymous with [OID.Len].
+*/
+func (r OID) Depth() int {
+	return r.Len()
+}
+
+/*
+IsRootArc returns a Boolean value indicative of whether the receiver
+consists of a single arc.
+*/
+func (r OID) IsRootArc() bool {
+	return r.Depth() == 1
+}
+
+/*
+IsSecondLevel returns a Boolean value indicative of whether the receiver
+consists of exactly two (2) arcs, such as {joint-iso-itu-t(2) example(999)}.
+*/
+func (r OID) IsSecondLevel() bool {
+	return r.Depth() == 2
+}
+
 /*
 Leaf returns the leaf-node instance of [NameAndNumberForm].
 */
@@ -110,6 +164,75 @@ func (r OID) Root() (nanf NameAndNumberForm) {
 	return
 }
 
+/*
+Equal returns a Boolean value indicative of whether the receiver and x
+describe the same OID, as determined by their numeric arcs alone. Names
+are not significant. See [DotNotation.Equal] for valid input types.
+*/
+func (r OID) Equal(x any) bool {
+	return equalArcs(r.nanf.arcs(), numericArcs(x))
+}
+
+/*
+MarshalText implements the [encoding.TextMarshaler] interface, returning
+the [ASN1Notation] string form of the receiver (e.g.: "{iso(1)
+identified-organization(3)}"). A zero receiver yields zero length text.
+*/
+func (r OID) MarshalText() (text []byte, err error) {
+	if !r.IsZero() {
+		text = []byte(r.nanf.String())
+	}
+
+	return
+}
+
+/*
+UnmarshalText implements the [encoding.TextUnmarshaler] interface, setting
+the receiver to the OID described by text, the notation of which is
+detected automatically, allowing a single field to ingest whatever notation
+an upstream system emits. Accepted notations are:
+
+  - ASN.1 notation, which must be braced (e.g.: "{iso(1) 3 dod(6)}")
+  - OID-IRI notation (e.g.: "/ISO/Identified-Organization/6")
+  - dot notation, in any spelling accepted by [NewDotNotation] (e.g.:
+    "1.3.6" or "urn:oid:1.3.6"), or a root arc alone (e.g.: "1")
+
+Arcs left unnamed by the input are named by their identifiers within the
+package-wide name dictionary, if any (see [RegisterIdentifier]). Zero
+length text, as well as text bearing only whitespace, yields a zero
+receiver. The receiver is left unmodified upon error.
+*/
+func (r *OID) UnmarshalText(text []byte) (err error) {
+	s := trimS(string(text))
+	if len(s) == 0 {
+		*r = OID{}
+		return
+	}
+
+	var o *OID
+	if hasPrefix(s, `{`) || hasPrefix(s, `/`) {
+		if o, err = NewOID(s); err != nil {
+			return
+		}
+		o.nanf = o.nanf.named()
+	} else {
+		d, ok := parseArcKey(s)
+		if !ok {
+			var D *DotNotation
+			if D, err = NewDotNotation(s); err != nil {
+				err = errorf("Unrecognized %T notation '%s': %v", r, s, err)
+				return
+			}
+			d = *D
+		}
+		o = &OID{nanf: d.ASN(), parsed: true}
+	}
+
+	*r = *o
+
+	return
+}
+
 /*
 NewOID creates an instance of [OID] and returns it alongside an error.
 
@@ -126,6 +249,11 @@ numbers alone or in the so-called nameAndNumber syntax (name(Number)). For examp
 
 ... is perfectly valid, but generally NOT recommended when clarity or precision is desired.
 
+Alternatively, variadic input allows for mixtures of the same types supported
+by [NewASN1Notation], each treated as an individual arc. For example:
+
+	NewOID(`itu-t`, `recommendation(0)`, 20, uint64(5))
+
 Note that the following root node abbreviations are supported:
 
   - `itu-t` resolves to itu-t(0)
@@ -136,9 +264,72 @@ Case is significant during processing of the above abbreviations.  Note that it
 inappropriate to utilize these abbreviations for any portion of an [OID] instance
 other than as the respective root node.
 
+A string bearing an OID-IRI (e.g.: "/ISO/Identified-Organization/6/1/4/1/56521")
+is also accepted, and is resolved in the manner of [IRINotation.Dot]. Each arc
+is named by its identifier within the name dictionary (see [RegisterIdentifier])
+or, failing that, by its lowercased primary Unicode label where the result is a
+valid identifier (see [RegisterUnicodeLabels]). Remaining arcs are unnamed.
+
 [NumberForm] values CANNOT be negative, but are unbounded in their magnitude.
 */
-func NewOID(x any) (r *OID, err error) {
+func NewOID(x ...any) (r *OID, err error) {
+	if len(x) == 1 {
+		switch tv := x[0].(type) {
+		case string:
+			if hasPrefix(trimS(tv), `/`) {
+				r, err = newOIDFromIRI(trimS(tv))
+			} else {
+				r, err = newOID(tv)
+			}
+			return
+		case []string, []NameAndNumberForm:
+			r, err = newOID(tv)
+			return
+		}
+	}
+
+	r = new(OID)
+	var a *ASN1Notation
+	if a, err = NewASN1Notation(x...); err == nil {
+		r.nanf = *a
+		r.parsed = true
+	}
+
+	return
+}
+
+/*
+newOIDFromIRI returns an instance of *[OID] resolved from the OID-IRI
+string iri, alongside an error.
+*/
+func newOIDFromIRI(iri string) (r *OID, err error) {
+	var i *IRINotation
+	if i, err = NewIRINotation(iri); err != nil {
+		return
+	}
+
+	d := i.Dot()
+	if d.IsZero() {
+		err = errorf("Unresolvable or invalid OID-IRI '%s'", iri)
+		return
+	}
+
+	a := d.ASN()
+	for j := 0; j < a.Len(); j++ {
+		if len(a[j].identifier) == 0 {
+			label := lc(primaryUnicodeLabel(d[:j+1].String(), d[j]))
+			if isIdentifier(label) {
+				a[j].identifier = label
+			}
+		}
+	}
+
+	r = &OID{nanf: a, parsed: true}
+
+	return
+}
+
+func newOID(x any) (r *OID, err error) {
 	// prepare temporary instance
 	t := new(OID)
 	r = new(OID)
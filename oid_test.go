@@ -163,3 +163,178 @@ func TestOID_bogus(t *testing.T) {
 		return
 	}
 }
+
+func ExampleOID_Depth() {
+	o, err := NewOID(`{joint-iso-itu-t(2) example(999)}`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%d %t %t", o.Depth(), o.IsRootArc(), o.IsSecondLevel())
+	// Output: 2 false true
+}
+
+func TestOID_Depth(t *testing.T) {
+	for x, want := range map[string][3]any{
+		`{iso(1)}`:                            {1, true, false},
+		`{iso(1) identified-organization(3)}`: {2, false, true},
+		`{iso(1) 3 6 1}`:                      {4, false, false},
+	} {
+		o, err := NewOID(x)
+		if err != nil {
+			t.Fatalf("%s failed: %v", t.Name(), err)
+		}
+		if got := [3]any{o.Depth(), o.IsRootArc(), o.IsSecondLevel()}; got != want {
+			t.Errorf("%s failed for %s: want %v, got %v", t.Name(), x, want, got)
+		}
+	}
+
+	var o OID
+	if o.Depth() != 0 || o.IsRootArc() || o.IsSecondLevel() {
+		t.Errorf("%s failed: zero instance misreported", t.Name())
+	}
+}
+
+func ExampleOID_Strings() {
+	o, err := NewOID(`{iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) 56521}`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	asn, dot := o.Strings()
+	fmt.Printf("%s\n%s", asn, dot)
+	// Output:
+	// {iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) 56521}
+	// 1.3.6.1.4.1.56521
+}
+
+func TestOID_Strings(t *testing.T) {
+	o, _ := NewOID(`{joint-iso-itu-t(2) example(999) 1}`)
+	if asn, dot := o.Strings(); asn != o.ASN().String() || dot != o.Dot().String() {
+		t.Errorf("%s failed: got '%s' and '%s'", t.Name(), asn, dot)
+	}
+
+	root, _ := NewOID(`{iso(1)}`)
+	if asn, dot := root.Strings(); asn != `{iso(1)}` || dot != `` {
+		t.Errorf("%s failed: got '%s' and '%s'", t.Name(), asn, dot)
+	}
+
+	var zero OID
+	if asn, dot := zero.Strings(); asn != `` || dot != `` {
+		t.Errorf("%s failed: zero instance returned '%s' and '%s'", t.Name(), asn, dot)
+	}
+}
+
+/*
+This example demonstrates the creation of an [OID] from variadic input
+comprised of mixed (supported) type instances.
+*/
+func ExampleNewOID_mixed() {
+	o, err := NewOID(`itu-t`, `recommendation(0)`, 20, uint64(5))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(o.ASN(), o.Dot())
+	// Output: {itu-t(0) recommendation(0) 20 5} 0.0.20.5
+}
+
+func TestNewOID_mixed(t *testing.T) {
+	nf, _ := NewNumberForm(`987895962269883002155146617097157934`)
+	o, err := NewOID(`joint-iso-itu-t`, `uuid(25)`, nf)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if got := o.Dot().String(); got != `2.25.987895962269883002155146617097157934` {
+		t.Errorf("%s failed: got '%s'", t.Name(), got)
+	} else if !o.Valid() {
+		t.Errorf("%s failed: result is invalid", t.Name())
+	}
+
+	for _, bogus := range [][]any{nil, {3, 1}, {1, -3}, {`iso`, 2.5}} {
+		if _, err = NewOID(bogus...); err == nil {
+			t.Errorf("%s failed: bogus input %v accepted", t.Name(), bogus)
+		}
+	}
+}
+
+func ExampleNewOID_iri() {
+	id, err := NewOID(`/ISO/Identified-Organization/6/1/4/1/56521`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(id.ASN())
+	// Output: {iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) 56521}
+}
+
+func TestNewOID_iri(t *testing.T) {
+	if err := RegisterUnicodeLabels(`2.999.8`, `Widget`); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	id, err := NewOID(`/Example/Widget/3`)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	if want, got := `{joint-iso-itu-t(2) example(999) widget(8) 3}`, id.ASN().String(); want != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+	}
+
+	for _, bogus := range []string{`/`, `/Example/Nonexistent`, `/-Bad-`} {
+		if _, err := NewOID(bogus); err == nil {
+			t.Errorf("%s failed: no error for '%s'", t.Name(), bogus)
+		}
+	}
+}
+
+func ExampleOID_UnmarshalText() {
+	var o OID
+	for _, text := range []string{
+		`1.3.6.1.4.1.56521`,
+		`{iso(1) 3 6 1 4 1 example(56521)}`,
+	} {
+		if err := o.UnmarshalText([]byte(text)); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(o.ASN())
+	}
+	// Output:
+	// {iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) 56521}
+	// {iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) example(56521)}
+}
+
+func TestOID_UnmarshalText(t *testing.T) {
+	for _, tc := range []struct {
+		text, asn string
+	}{
+		{`2.999`, `{joint-iso-itu-t(2) example(999)}`},
+		{` urn:oid:2.999.1 `, `{joint-iso-itu-t(2) example(999) 1}`},
+		{`1`, `{iso(1)}`},
+		{`{2 999 x(5)}`, `{joint-iso-itu-t(2) example(999) x(5)}`},
+		{`/Joint-ISO-ITU-T/Example`, `{joint-iso-itu-t(2) example(999)}`},
+		{``, `{}`},
+	} {
+		var o OID
+		if err := o.UnmarshalText([]byte(tc.text)); err != nil {
+			t.Errorf("%s failed for '%s': %v", t.Name(), tc.text, err)
+		} else if got := o.ASN().String(); got != tc.asn {
+			t.Errorf("%s failed for '%s': want %s, got %s", t.Name(), tc.text, tc.asn, got)
+		} else if text, _ := o.MarshalText(); len(tc.text) > 0 && string(text) != tc.asn {
+			t.Errorf("%s failed: want text %s, got %s", t.Name(), tc.asn, text)
+		}
+	}
+
+	o, _ := NewOID(`{joint-iso-itu-t(2) example(999)}`)
+	for _, bad := range []string{`3.1`, `{3 1}`, `1.3.x`, `example(999)`} {
+		if err := o.UnmarshalText([]byte(bad)); err == nil {
+			t.Errorf("%s failed: expected error for '%s'", t.Name(), bad)
+		} else if o.Dot().String() != `2.999` {
+			t.Errorf("%s failed: receiver modified upon error", t.Name())
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package objectid
+
+/*
+oidinfo.go implements import and export of Registry contents using the
+XML interchange format of the OID repository (oid-info.com).
+*/
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+/*
+OIDInfoNamespace is the XML namespace of the oid-info.com interchange
+format, as written by [Registry.ExportOIDInfo].
+*/
+const OIDInfoNamespace = `http://oid-info.com`
+
+/*
+oidInfoDate is the layout of the date values within the oid-info.com
+interchange format.
+*/
+const oidInfoDate = `2006-01-02`
+
+/*
+oidInfoDatabase is the document element of the oid-info.com interchange
+format.
+*/
+type oidInfoDatabase struct {
+	XMLName xml.Name       `xml:"oid-database"`
+	Xmlns   string         `xml:"xmlns,attr,omitempty"`
+	OIDs    []oidInfoEntry `xml:"oid"`
+}
+
+/*
+oidInfoEntry describes a single OID within the oid-info.com interchange
+format.
+*/
+type oidInfoEntry struct {
+	Dot         string             `xml:"dot-notation"`
+	ASN1        string             `xml:"asn1-notation,omitempty"`
+	Description string             `xml:"description,omitempty"`
+	Information string             `xml:"information,omitempty"`
+	Registrant  *oidInfoRegistrant `xml:"current-registrant,omitempty"`
+}
+
+/*
+oidInfoRegistrant describes the current registrant of an OID within the
+oid-info.com interchange format.
+*/
+type oidInfoRegistrant struct {
+	FirstName string `xml:"first-name,omitempty"`
+	LastName  string `xml:"last-name,omitempty"`
+	Address   string `xml:"address,omitempty"`
+	Email     string `xml:"email,omitempty"`
+	Created   string `xml:"creation-date,omitempty"`
+	Modified  string `xml:"modification-date,omitempty"`
+}
+
+/*
+ImportOIDInfo reads an oid-info.com XML document from rd into the receiver,
+returning an error if the operation fails. Each "oid" element yields a
+single [Record], as follows:
+
+  - dot-notation provides the [DotNotation]
+  - the final arc of asn1-notation, if named, provides the Identifier
+  - description provides the Description, or information in its absence
+  - current-registrant, if present, provides the Authority, the Name of
+    which is the registrant's first and last names, and the Contact of
+    which is the registrant's email address, or postal address in its
+    absence
+
+Elements of the format not listed above are ignored. Records imported
+replace any existing records bearing the same [DotNotation], and new
+records are subject to the [Constraint] checks of [Registry.Register];
+upon error, the receiver is left unmodified.
+*/
+func (r *Registry) ImportOIDInfo(rd io.Reader) error {
+	return r.ImportOIDInfoContext(context.Background(), rd)
+}
+
+/*
+ImportOIDInfoContext is the same as [Registry.ImportOIDInfo], except that
+the import ceases with the error of ctx once ctx is done, leaving the
+receiver unmodified.
+*/
+func (r *Registry) ImportOIDInfoContext(ctx context.Context, rd io.Reader) (err error) {
+	dec := xml.NewDecoder(rd)
+
+	var recs []Record
+	for {
+		var tok xml.Token
+		if err = canceled(ctx); err != nil {
+			return
+		} else if tok, err = dec.Token(); err == io.EOF {
+			err = nil
+			break
+		} else if err != nil {
+			return
+		}
+
+		// Decode each oid element individually, such that
+		// large dumps need not be held in their entirety.
+		se, ok := tok.(xml.StartElement)
+		if !ok || se.Name.Local != `oid` {
+			continue
+		}
+
+		var (
+			ent oidInfoEntry
+			rec Record
+		)
+		if err = dec.DecodeElement(&ent, &se); err != nil {
+			return
+		} else if rec, err = ent.record(); err != nil {
+			err = errorf("OID %d: %v", len(recs), err)
+			return
+		}
+		recs = append(recs, rec)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	err = r.storeAll(recs)
+
+	return
+}
+
+/*
+record returns the [Record] described by the receiver, alongside an error.
+*/
+func (r oidInfoEntry) record() (rec Record, err error) {
+	dot := trimS(r.Dot)
+	var ok bool
+	if rec.Dot, ok = parseArcKey(dot); !ok {
+		err = errorf("Invalid dot-notation '%s'", dot)
+		return
+	}
+
+	if nfs := fields(trimR(trimL(r.ASN1, `{ `), `} `)); len(nfs) > 0 {
+		if nanf, e := NewNameAndNumberForm(nfs[len(nfs)-1]); e == nil {
+			rec.Identifier = nanf.Identifier()
+		}
+	}
+
+	if rec.Description = trimS(r.Description); len(rec.Description) == 0 {
+		rec.Description = trimS(r.Information)
+	}
+
+	if reg := r.Registrant; reg != nil {
+		ra := &RegistrationAuthority{
+			Name:    trimS(trimS(reg.FirstName) + ` ` + trimS(reg.LastName)),
+			Contact: trimS(reg.Email),
+		}
+		if len(ra.Contact) == 0 {
+			ra.Contact = trimS(reg.Address)
+		}
+		if ra.Created, err = parseOIDInfoDate(reg.Created); err != nil {
+			return
+		} else if ra.Modified, err = parseOIDInfoDate(reg.Modified); err != nil {
+			return
+		}
+		if len(ra.Name) > 0 {
+			rec.Authority = ra
+		}
+	}
+
+	err = rec.validate()
+
+	return
+}
+
+/*
+parseOIDInfoDate returns the time described by the oid-info.com date
+value s, or the zero time if s is zero length.
+*/
+func parseOIDInfoDate(s string) (t time.Time, err error) {
+	if s = trimS(s); len(s) > 0 {
+		if t, err = time.Parse(oidInfoDate, s); err != nil {
+			err = errorf("Invalid date '%s'", s)
+		}
+	}
+
+	return
+}
+
+/*
+ExportOIDInfo writes all records within the receiver that reside at or
+beneath subtree to w as an oid-info.com XML document, suitable for
+submission to the OID repository, returning an error if the operation
+fails. See [Registry.ImportOIDInfo] for the mapping of [Record] fields.
+
+The asn1-notation of each record is composed of the identifiers of the
+record and its ancestors, as found within the receiver or the package-wide
+name dictionary. The Contact of an Authority is written as an email
+address if it bears an at sign ("@"), or as a postal address otherwise.
+
+The subtree is interpreted in the manner of [Registry.Subtree].
+*/
+func (r *Registry) ExportOIDInfo(w io.Writer, subtree any) (err error) {
+	var sub RegistrySubtree
+	if sub, err = r.exportSubtree(subtree, `oid-info`); err != nil {
+		return
+	}
+
+	// Ancestors of the subtree may reside outside of it, and
+	// so their identifiers are sought within the receiver.
+	ids := make(map[string]string)
+	name := func(d DotNotation) (id string) {
+		key := d.String()
+		var found bool
+		if id, found = ids[key]; !found {
+			if rec, ok := r.Lookup(d); ok && len(rec.Identifier) > 0 {
+				id = rec.Identifier
+			} else {
+				id, _ = lookupIdentifier(key)
+			}
+			ids[key] = id
+		}
+		return
+	}
+
+	db := oidInfoDatabase{Xmlns: OIDInfoNamespace}
+	recs := sub.Records()
+	for i := 0; i < len(recs); i++ {
+		db.OIDs = append(db.OIDs, oidInfoEntryOf(recs[i], name))
+	}
+
+	if _, err = io.WriteString(w, xml.Header); err != nil {
+		return
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent(``, "\t")
+	if err = enc.Encode(db); err == nil {
+		_, err = io.WriteString(w, "\n")
+	}
+
+	return
+}
+
+/*
+oidInfoEntryOf returns the oidInfoEntry describing rec, using name to
+identify rec and each of its ancestors.
+*/
+func oidInfoEntryOf(rec Record, name func(DotNotation) string) (ent oidInfoEntry) {
+	ent.Dot = rec.Dot.String()
+	ent.Description = rec.Description
+
+	asn := make(ASN1Notation, rec.Dot.Len())
+	for i := 0; i < rec.Dot.Len(); i++ {
+		asn[i] = NameAndNumberForm{identifier: name(rec.Dot[: i+1 : i+1]), primaryIdentifier: rec.Dot[i], parsed: true}
+	}
+	ent.ASN1 = asn.String()
+
+	if ra := rec.Authority; ra != nil {
+		reg := &oidInfoRegistrant{LastName: ra.Name}
+		if contains(ra.Contact, `@`) {
+			reg.Email = ra.Contact
+		} else {
+			reg.Address = ra.Contact
+		}
+		if !ra.Created.IsZero() {
+			reg.Created = ra.Created.UTC().Format(oidInfoDate)
+		}
+		if !ra.Modified.IsZero() {
+			reg.Modified = ra.Modified.UTC().Format(oidInfoDate)
+		}
+		ent.Registrant = reg
+	}
+
+	return
+}
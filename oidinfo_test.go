@@ -0,0 +1,101 @@
+package objectid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func ExampleRegistry_ImportOIDInfo() {
+	doc := `<?xml version="1.0" encoding="UTF-8"?>
+<oid-database xmlns="http://oid-info.com">
+	<oid>
+		<dot-notation>1.3.6.1.4.1.56521</dot-notation>
+		<asn1-notation>{iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) example(56521)}</asn1-notation>
+		<description>Example Corp.</description>
+	</oid>
+</oid-database>`
+
+	reg := NewRegistry()
+	if err := reg.ImportOIDInfo(strings.NewReader(doc)); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	rec, _ := reg.Lookup(`1.3.6.1.4.1.56521`)
+	fmt.Printf("%s %s: %s", rec.Dot, rec.Identifier, rec.Description)
+	// Output: 1.3.6.1.4.1.56521 example: Example Corp.
+}
+
+func TestRegistry_OIDInfo(t *testing.T) {
+	reg := newTestRegistry(t)
+	created := time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC)
+	_ = reg.Register(Record{
+		Dot:         mustDot(`1.3.6.1.4.1.56521.1`),
+		Identifier:  `products`,
+		Description: `Products & Services`,
+		Authority: &RegistrationAuthority{
+			Name:    `Example Corp.`,
+			Contact: `oid@example.com`,
+			Created: created,
+		},
+	})
+
+	var out strings.Builder
+	if err := reg.ExportOIDInfo(&out, `1.3.6.1.4.1.56521`); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	xml := out.String()
+	for _, want := range []string{
+		`<oid-database xmlns="http://oid-info.com">`,
+		`<dot-notation>1.3.6.1.4.1.56521.1</dot-notation>`,
+		`<asn1-notation>{iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) 56521 products(1)}</asn1-notation>`,
+		`<description>Products &amp; Services</description>`,
+		`<last-name>Example Corp.</last-name>`,
+		`<email>oid@example.com</email>`,
+		`<creation-date>2019-07-01</creation-date>`,
+	} {
+		if !strings.Contains(xml, want) {
+			t.Errorf("%s failed: output lacks %s:\n%s", t.Name(), want, xml)
+		}
+	}
+	if strings.Contains(xml, `<dot-notation>1.3.6.1.4.1</dot-notation>`) {
+		t.Errorf("%s failed: output includes record outside subtree:\n%s", t.Name(), xml)
+	}
+
+	loaded := NewRegistry()
+	if err := loaded.ImportOIDInfo(strings.NewReader(xml)); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+	rec, found := loaded.Lookup(`1.3.6.1.4.1.56521.1`)
+	if !found || rec.Identifier != `products` || rec.Description != `Products & Services` {
+		t.Errorf("%s failed: unexpected record %#v", t.Name(), rec)
+	} else if ra := rec.Authority; ra == nil || ra.Name != `Example Corp.` ||
+		ra.Contact != `oid@example.com` || !ra.Created.Equal(created) {
+		t.Errorf("%s failed: unexpected authority %#v", t.Name(), ra)
+	}
+
+	for _, bad := range []string{
+		`<oid-database><oid><dot-notation>3.1</dot-notation></oid></oid-database>`,
+		`<oid-database><oid><dot-notation>2.999</dot-notation><current-registrant>` +
+			`<last-name>x</last-name><creation-date>yesterday</creation-date></current-registrant></oid></oid-database>`,
+		`<oid-database><oid>`,
+	} {
+		if err := loaded.ImportOIDInfo(strings.NewReader(bad)); err == nil {
+			t.Errorf("%s failed: expected error for %s", t.Name(), bad)
+		}
+	}
+	if _, found = loaded.Lookup(`2.999`); found {
+		t.Errorf("%s failed: failed import modified the registry", t.Name())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := loaded.ImportOIDInfoContext(ctx, strings.NewReader(xml)); !errors.Is(err, context.Canceled) {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), context.Canceled, err)
+	}
+}
@@ -0,0 +1,580 @@
+package objectid
+
+/*
+options.go contains option types used to alter codec behavior.
+*/
+
+/*
+EncodingOption is a function type used to alter the behavior of the ASN.1
+codec methods, such as [DotNotation.Encode].
+*/
+type EncodingOption func(*encodingConfig)
+
+/*
+encodingConfig contains the effective settings for a single codec
+operation, as assembled from zero or more instances of EncodingOption.
+*/
+type encodingConfig struct {
+	tag            byte
+	maxContent     int
+	maxSubidOctets int
+	subMinimal     bool
+	err            error
+}
+
+const (
+	// DefaultMaxContentLength is the default maximum number of
+	// contents octets permitted within an encoded OID, whether
+	// encoded or decoded. See [WithMaxContentLength].
+	DefaultMaxContentLength = 4096
+
+	// DefaultMaxSubidentifierOctets is the default maximum number
+	// of octets permitted within a single encoded subidentifier,
+	// allowing arcs of up to 518 bits, such that any OID parsed
+	// within the [DefaultMaxArcDigits] may be decoded once encoded.
+	// See [WithMaxSubidentifierOctets].
+	DefaultMaxSubidentifierOctets = 74
+)
+
+/*
+newEncodingConfig returns a populated instance of *encodingConfig based
+on the input EncodingOption instances.
+*/
+func newEncodingConfig(opts ...EncodingOption) (cfg *encodingConfig) {
+	cfg = &encodingConfig{
+		tag:            0x06,
+		maxContent:     DefaultMaxContentLength,
+		maxSubidOctets: DefaultMaxSubidentifierOctets,
+	}
+	for i := 0; i < len(opts); i++ {
+		if opts[i] != nil {
+			opts[i](cfg)
+		}
+	}
+
+	return
+}
+
+/*
+WithTag returns an [EncodingOption] which replaces the ASN.1 OBJECT
+IDENTIFIER tag (0x06) with the identifier octet tag. Only single-octet,
+primitive identifiers are supported.
+*/
+func WithTag(tag byte) EncodingOption {
+	return func(cfg *encodingConfig) {
+		if tag&0x1F == 0x1F || tag&0x20 != 0 {
+			cfg.err = errorf("Tag 0x%02x is not a single-octet primitive identifier", tag)
+			return
+		}
+		cfg.tag = tag
+	}
+}
+
+/*
+WithImplicitTag returns an [EncodingOption] which replaces the ASN.1 OBJECT
+IDENTIFIER tag (0x06) with a context-specific primitive tag of number n, as
+would be produced by an "[n] IMPLICIT OBJECT IDENTIFIER" field. Tag number
+n must be between zero (0) and thirty (30), inclusive.
+*/
+func WithImplicitTag(n int) EncodingOption {
+	return func(cfg *encodingConfig) {
+		if !(0 <= n && n <= 30) {
+			cfg.err = errorf("Implicit tag number %d outside supported range [0-30]", n)
+			return
+		}
+		cfg.tag = 0x80 | byte(n)
+	}
+}
+
+/*
+WithMaxContentLength returns an [EncodingOption] which limits the number
+of contents octets permitted within an encoded OID to n, overriding the
+[DefaultMaxContentLength]. Encodings exceeding the limit are rejected
+before any decoding takes place, and are not produced by
+[DotNotation.Encode]. A value of zero (0) removes the limit, which is NOT
+recommended when processing untrusted input.
+*/
+func WithMaxContentLength(n int) EncodingOption {
+	return func(cfg *encodingConfig) {
+		if n < 0 {
+			cfg.err = errorf("Maximum content length cannot be negative")
+			return
+		}
+		cfg.maxContent = n
+	}
+}
+
+/*
+WithMaxSubidentifierOctets returns an [EncodingOption] which limits the
+number of octets permitted within a single encoded subidentifier to n,
+overriding the [DefaultMaxSubidentifierOctets]. This bounds the magnitude
+of any single decoded [NumberForm]. A value of zero (0) removes the limit,
+which is NOT recommended when processing untrusted input.
+*/
+func WithMaxSubidentifierOctets(n int) EncodingOption {
+	return func(cfg *encodingConfig) {
+		if n < 0 {
+			cfg.err = errorf("Maximum subidentifier octets cannot be negative")
+			return
+		}
+		cfg.maxSubidOctets = n
+	}
+}
+
+/*
+AllowSubMinimal returns an [EncodingOption] which permits the encoding and
+decoding of sub-minimal OIDs consisting of a root arc alone (e.g.: "2"),
+for interoperability with legacy peers which emit or expect such values.
+
+THIS IS NOT CONFORMANT with ITU-T Rec. X.690, and is inherently ambiguous.
+When encoding, a root arc alone is encoded as though it were followed by
+a second-level arc of zero (0), such that "2" and "2.0" produce identical
+encodings. When decoding, any such encoding (i.e.: a single contents octet
+of 0x00, 0x28 or 0x50) produces the root arc alone, meaning a genuine
+"2.0" cannot be distinguished. Use this option only when communicating
+with peers known to require it.
+*/
+func AllowSubMinimal() EncodingOption {
+	return func(cfg *encodingConfig) {
+		cfg.subMinimal = true
+	}
+}
+
+/*
+checkContentLength returns an error if length exceeds the maximum
+content length of the receiver.
+*/
+func (r *encodingConfig) checkContentLength(length int) (err error) {
+	if r.maxContent > 0 && length > r.maxContent {
+		err = errorf("Content length %d exceeds maximum of %d octets", length, r.maxContent)
+	}
+
+	return
+}
+
+/*
+checkSubidOctets returns an error if the subidentifier octet count n
+exceeds the maximum of the receiver.
+*/
+func (r *encodingConfig) checkSubidOctets(n int) (err error) {
+	if r.maxSubidOctets > 0 && n > r.maxSubidOctets {
+		err = errorf("Subidentifier exceeds maximum of %d octets", r.maxSubidOctets)
+	}
+
+	return
+}
+
+/*
+ParseOption is a function type used to alter the behavior of parsers,
+such as [NewDotNotation]. Instances of this type may be mixed freely
+with other variadic input.
+*/
+type ParseOption func(*parseConfig)
+
+/*
+parseConfig contains the effective settings for a single parse
+operation, as assembled from zero or more instances of ParseOption.
+*/
+type parseConfig struct {
+	leadingZeros    leadingZeroPolicy
+	whitespace      bool
+	ldapIdentifiers bool
+	intern          bool
+	maxDigits       int
+}
+
+/*
+DefaultMaxArcDigits is the default maximum number of significant decimal
+digits permitted within a single numeric arc during parsing, allowing arcs
+below 10^155 (i.e.: of up to 515 bits), all of which encode within the
+[DefaultMaxSubidentifierOctets]. See [WithMaxArcDigits].
+*/
+const DefaultMaxArcDigits = 155
+
+/*
+leadingZeroPolicy defines the handling of numeric arcs bearing leading
+zeros (e.g.: "03").
+*/
+type leadingZeroPolicy uint8
+
+const (
+	normalizeLeadingZeros leadingZeroPolicy = iota // default
+	rejectLeadingZeros
+)
+
+/*
+newParseConfig returns a populated instance of *parseConfig based on the
+input ParseOption instances.
+*/
+func newParseConfig(opts ...ParseOption) (cfg *parseConfig) {
+	cfg = &parseConfig{maxDigits: DefaultMaxArcDigits}
+	for i := 0; i < len(opts); i++ {
+		if opts[i] != nil {
+			opts[i](cfg)
+		}
+	}
+
+	return
+}
+
+/*
+splitParseOptions returns x with all [ParseOption] instances removed,
+alongside the ParseOption instances found.
+*/
+func splitParseOptions(x []any) (vals []any, opts []ParseOption) {
+	for i := 0; i < len(x); i++ {
+		if opt, ok := x[i].(ParseOption); ok {
+			opts = append(opts, opt)
+		} else {
+			vals = append(vals, x[i])
+		}
+	}
+
+	return
+}
+
+/*
+RejectLeadingZeros returns a [ParseOption] which causes numeric arcs
+bearing leading zeros (e.g.: "1.03.6") to be rejected with an error. This
+is appropriate in canonicalization-sensitive contexts, as such spellings
+do not survive a round-trip through the String method.
+*/
+func RejectLeadingZeros() ParseOption {
+	return func(cfg *parseConfig) {
+		cfg.leadingZeros = rejectLeadingZeros
+	}
+}
+
+/*
+NormalizeLeadingZeros returns a [ParseOption] which causes numeric arcs
+bearing leading zeros (e.g.: "1.03.6") to be silently normalized (e.g.:
+"1.3.6"). This is the default behavior, and this option exists so that
+callers may state the behavior explicitly.
+*/
+func NormalizeLeadingZeros() ParseOption {
+	return func(cfg *parseConfig) {
+		cfg.leadingZeros = normalizeLeadingZeros
+	}
+}
+
+/*
+WithMaxArcDigits returns a [ParseOption] which limits the number of
+significant decimal digits permitted within a single numeric arc to n,
+beyond which parsing fails with an error. This guards against untrusted
+input bearing absurdly long arcs, the parsing of which would otherwise
+consume memory and time in proportion to its length. A value of n less
+than or equal to zero (0) removes the limit.
+
+By default, [DefaultMaxArcDigits] applies, which permits the 128-bit arcs
+of UUID-based OIDs (see [NewUUIDv5DotNotation]) with a generous margin.
+*/
+func WithMaxArcDigits(n int) ParseOption {
+	return func(cfg *parseConfig) {
+		cfg.maxDigits = n
+	}
+}
+
+/*
+checkArc returns an error if the numeric arc string violates the leading
+zero policy or the digit limit of the receiver.
+*/
+func (r *parseConfig) checkArc(arc string) (err error) {
+	if r.leadingZeros == rejectLeadingZeros && len(arc) > 1 && arc[0] == '0' {
+		err = errorf("Arc '%s' bears leading zeros", arc)
+	} else if r.maxDigits > 0 && len(arc) > r.maxDigits {
+		if digits := len(trimL(arc, `0`)); digits > r.maxDigits {
+			err = errorf("Arc bears %d digits, exceeding the maximum of %d", digits, r.maxDigits)
+		}
+	}
+
+	return
+}
+
+/*
+AllowWhitespace returns a [ParseOption] which causes leading and trailing
+whitespace (e.g.: " 1.3.6.1\n"), as well as whitespace adjacent to dots
+(e.g.: "1. 3 .6"), to be tolerated during parsing. Whitespace within an
+arc (e.g.: "1.3 6") is still rejected, as the intended value is ambiguous.
+
+By default, whitespace is tolerated only as arc delimiters within the
+space-delimited and braced forms described by [NewDotNotation].
+*/
+func AllowWhitespace() ParseOption {
+	return func(cfg *parseConfig) {
+		cfg.whitespace = true
+	}
+}
+
+/*
+LDAPIdentifiers returns a [ParseOption] which causes identifiers to be
+judged per the descr production of RFC 4512 (see [ClassifyOIDToken])
+rather than per ITU-T Rec. X.680. Such identifiers may begin with an
+uppercase letter, and may bear consecutive or trailing hyphens.
+
+By default, ITU-T Rec. X.680 rules apply (see [IsIdentifier]).
+*/
+func LDAPIdentifiers() ParseOption {
+	return func(cfg *parseConfig) {
+		cfg.ldapIdentifiers = true
+	}
+}
+
+/*
+checkIdentifier returns an error if id does not satisfy the identifier
+rules of the receiver.
+*/
+func (r *parseConfig) checkIdentifier(id string) (err error) {
+	if r.ldapIdentifiers {
+		if !isLDAPDescr(id) {
+			err = errorf("Invalid RFC 4512 descr '%s'", id)
+		}
+	} else if !isIdentifier(id) {
+		err = errorf("Invalid ITU-T Rec. X.680 identifier '%s'", id)
+	}
+
+	return
+}
+
+/*
+prepareDot returns the dot notation string dot following the removal of
+whitespace, if permitted by the receiver.
+*/
+func (r *parseConfig) prepareDot(dot string) (out string, err error) {
+	if out = dot; !r.whitespace {
+		return
+	}
+
+	arcs := split(trimS(dot), `.`)
+	for i := 0; i < len(arcs); i++ {
+		if arcs[i], err = r.prepareArc(arcs[i]); err != nil {
+			return
+		}
+	}
+	out = join(arcs, `.`)
+
+	return
+}
+
+/*
+prepareArc returns the single arc string following the removal of
+surrounding whitespace, if permitted by the receiver.
+*/
+func (r *parseConfig) prepareArc(arc string) (out string, err error) {
+	if out = arc; r.whitespace {
+		out = trimS(arc)
+		if indexFunc(out, isSpace) != -1 {
+			err = errorf("Arc '%s' contains internal whitespace", out)
+		}
+	}
+
+	return
+}
+
+/*
+TableOption is a function type used to alter the behavior of the tabular
+(CSV and TSV) registry interchange methods, such as [Registry.ImportTable]
+and [Registry.ExportTable].
+*/
+type TableOption func(*tableConfig)
+
+/*
+tableConfig contains the effective settings for a single tabular import
+or export, as assembled from zero or more instances of TableOption.
+*/
+type tableConfig struct {
+	comma   rune
+	header  bool
+	columns [3]int    // dot, identifier, description; -1 if absent
+	names   [3]string // header names, overriding columns if set
+
+	authority bool
+	raColumns [len(tableAuthorityColumns)]int // -1 if absent
+
+	streaming bool
+	interval  int
+	progress  func(int64)
+
+	err error
+}
+
+/*
+newTableConfig returns a populated instance of *tableConfig based on the
+input TableOption instances.
+*/
+func newTableConfig(opts ...TableOption) (cfg *tableConfig) {
+	cfg = &tableConfig{
+		comma:     ',',
+		columns:   [3]int{0, 1, 2},
+		raColumns: [...]int{3, 4, 5, 6, 7, 8},
+	}
+	for i := 0; i < len(opts); i++ {
+		if opts[i] != nil {
+			opts[i](cfg)
+		}
+	}
+
+	return
+}
+
+/*
+WithDelimiter returns a [TableOption] which replaces the default comma
+field delimiter with comma, such as a tab ('\t') for TSV. The delimiter
+may not be a quote, carriage return, newline or the Unicode replacement
+character.
+*/
+func WithDelimiter(comma rune) TableOption {
+	return func(cfg *tableConfig) {
+		switch comma {
+		case '"', '\r', '\n', 0xFFFD:
+			cfg.err = errorf("Invalid table delimiter %q", comma)
+			return
+		}
+		cfg.comma = comma
+	}
+}
+
+/*
+WithHeader returns a [TableOption] which indicates that the first row of
+a table contains column names. Upon import, the first row is skipped; upon
+export, a row bearing the names "oid", "identifier" and "description",
+as well as those described by [WithAuthority] if specified, is written
+first.
+*/
+func WithHeader() TableOption {
+	return func(cfg *tableConfig) {
+		cfg.header = true
+	}
+}
+
+/*
+WithColumns returns a [TableOption] which maps the zero-based column
+indices of a table to the fields of each [Record] upon import. The dot
+column is required, while a negative identifier or description index
+indicates the field is absent. By default, the columns are zero (0), one
+(1) and two (2) respectively. Columns not mapped are ignored.
+*/
+func WithColumns(dot, identifier, description int) TableOption {
+	return func(cfg *tableConfig) {
+		if dot < 0 {
+			cfg.err = errorf("The dot notation column is required")
+			return
+		}
+		cfg.columns = [3]int{dot, identifier, description}
+	}
+}
+
+/*
+WithColumnNames returns a [TableOption] which maps the case-insensitive
+column names found within the header row of a table to the fields of each
+[Record] upon import, implying [WithHeader]. The dot column is required,
+while a zero identifier or description name indicates the field is absent.
+This is useful for spreadsheets whose column order is not fixed.
+*/
+func WithColumnNames(dot, identifier, description string) TableOption {
+	return func(cfg *tableConfig) {
+		if len(dot) == 0 {
+			cfg.err = errorf("The dot notation column is required")
+			return
+		}
+		cfg.header = true
+		cfg.names = [3]string{dot, identifier, description}
+	}
+}
+
+/*
+tableAuthorityColumns contains the names of the columns which describe
+the [RegistrationAuthority] of a [Record], in their default order. See
+[WithAuthority].
+*/
+var tableAuthorityColumns = [...]string{`authority`, `contact`, `url`, `status`, `created`, `modified`}
+
+/*
+WithAuthority returns a [TableOption] which causes the [RegistrationAuthority]
+of each [Record] to be described by six (6) additional columns, following
+the description column: authority (the Name), contact, url, status (e.g.:
+"active"), created and modified, the latter two bearing RFC 3339 times.
+
+Upon export, these columns are written, and their names are included
+within the header row, if any. Upon import, these columns are read from
+indices three (3) through eight (8), or, if [WithColumnNames] is
+specified, from the header columns bearing their names, any of which may
+be absent. A row bearing no authority value yields a nil Authority.
+
+By default, authority metadata is neither written nor read.
+*/
+func WithAuthority() TableOption {
+	return func(cfg *tableConfig) {
+		cfg.authority = true
+	}
+}
+
+/*
+WithStreaming returns a [TableOption] which causes [Registry.ImportTable]
+to register each record as it is read, rather than upon completion. This
+bounds memory use to a single row regardless of the size of the source,
+at the cost of atomicity: upon error, records read prior to the offending
+row remain registered.
+*/
+func WithStreaming() TableOption {
+	return func(cfg *tableConfig) {
+		cfg.streaming = true
+	}
+}
+
+/*
+WithProgress returns a [TableOption] which causes fn to be called with
+the cumulative number of records read after every interval records, so
+that the progress of long imports may be reported. Should the final count
+not fall upon an interval, fn is called once more upon completion. The
+header row, if any, is not counted. The interval must be greater than
+zero (0).
+*/
+func WithProgress(interval int, fn func(records int64)) TableOption {
+	return func(cfg *tableConfig) {
+		if interval < 1 || fn == nil {
+			cfg.err = errorf("Progress requires a positive interval and a non-nil function")
+			return
+		}
+		cfg.interval, cfg.progress = interval, fn
+	}
+}
+
+/*
+resolveColumns updates the column indices of the receiver using the
+header row hdr, if column names were specified.
+*/
+func (r *tableConfig) resolveColumns(hdr []string) (err error) {
+	if len(r.names[0]) == 0 {
+		return
+	}
+
+	for i := 0; i < len(r.names); i++ {
+		r.columns[i] = -1
+		if len(r.names[i]) == 0 {
+			continue
+		}
+		for j := 0; j < len(hdr); j++ {
+			if eq(trimS(hdr[j]), r.names[i]) {
+				r.columns[i] = j
+				break
+			}
+		}
+		if r.columns[i] < 0 {
+			err = errorf("Column '%s' not found in table header", r.names[i])
+			return
+		}
+	}
+
+	// Authority columns are optional when located by name.
+	for i := 0; i < len(tableAuthorityColumns); i++ {
+		r.raColumns[i] = -1
+		for j := 0; j < len(hdr); j++ {
+			if eq(trimS(hdr[j]), tableAuthorityColumns[i]) {
+				r.raColumns[i] = j
+				break
+			}
+		}
+	}
+
+	return
+}
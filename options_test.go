@@ -0,0 +1,386 @@
+package objectid
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+/*
+This example demonstrates the encoding of a [DotNotation] instance as
+an "[0] IMPLICIT OBJECT IDENTIFIER" field.
+*/
+func ExampleWithImplicitTag() {
+	dot, _ := NewDotNotation(`1.3.6.1`)
+	b, err := dot.Encode(WithImplicitTag(0))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%#x", b)
+	// Output: 0x80032b0601
+}
+
+func TestEncodingOptions(t *testing.T) {
+	dot, _ := NewDotNotation(`1.3.6.1`)
+	for tag, opt := range map[byte]EncodingOption{
+		0x06: nil,
+		0x83: WithImplicitTag(3),
+		0x9e: WithImplicitTag(30),
+		0x46: WithTag(0x46),
+	} {
+		b, err := dot.Encode(opt)
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if b[0] != tag {
+			t.Errorf("%s failed: want tag 0x%02x, got 0x%02x", t.Name(), tag, b[0])
+		}
+	}
+
+	for idx, opt := range []EncodingOption{
+		WithImplicitTag(-1),
+		WithImplicitTag(31),
+		WithTag(0x1f),
+		WithTag(0xa0),
+	} {
+		if _, err := dot.Encode(opt); err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nothing", t.Name(), idx)
+		}
+	}
+}
+
+/*
+This example demonstrates the decoding of an "[2] IMPLICIT OBJECT
+IDENTIFIER" field extracted from a larger structure.
+*/
+func ExampleDotNotation_Decode_withImplicitTag() {
+	var dot DotNotation
+	if err := dot.Decode([]byte{0x82, 0x03, 0x2b, 0x06, 0x01}, WithImplicitTag(2)); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(dot)
+	// Output: 1.3.6.1
+}
+
+func TestDecodingOptions(t *testing.T) {
+	tagged := []byte{0x85, 0x03, 0x2b, 0x06, 0x01}
+
+	var dot DotNotation
+	if err := dot.Decode(tagged); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+	if err := dot.Decode(tagged, WithImplicitTag(4)); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+	if err := dot.Decode(tagged, WithImplicitTag(99)); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+	if _, _, err := DecodeNext(tagged, WithTag(0x3f)); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+
+	d, rest, err := DecodeNext(append(tagged, tagged...), WithImplicitTag(5))
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if d.String() != `1.3.6.1` || len(rest) != len(tagged) {
+		t.Errorf("%s failed: unexpected result %s (%d bytes remaining)", t.Name(), d, len(rest))
+	}
+
+	scanner := NewScanner(bytes.NewReader(append(tagged, tagged...)), WithImplicitTag(5))
+	for scanner.Scan() {
+	}
+	if err = scanner.Err(); err != nil || scanner.Records() != 2 {
+		t.Errorf("%s failed: %v (%d records)", t.Name(), err, scanner.Records())
+	}
+}
+
+func ExampleRejectLeadingZeros() {
+	_, err := NewDotNotation(`1.03.6`, RejectLeadingZeros())
+	fmt.Println(err)
+	// Output: Arc '03' bears leading zeros
+}
+
+func TestParseOptions_leadingZeros(t *testing.T) {
+	dot, err := NewDotNotation(`1.03.006.1`)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if dot.String() != `1.3.6.1` {
+		t.Errorf("%s failed: want '1.3.6.1', got '%s'", t.Name(), dot)
+	}
+
+	if dot, err = NewDotNotation(NormalizeLeadingZeros(), `1.03.6`); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if dot.String() != `1.3.6` {
+		t.Errorf("%s failed: want '1.3.6', got '%s'", t.Name(), dot)
+	}
+
+	if dot, err = NewDotNotation(`1.3.0.10`, RejectLeadingZeros()); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if dot.String() != `1.3.0.10` {
+		t.Errorf("%s failed: want '1.3.0.10', got '%s'", t.Name(), dot)
+	}
+
+	for idx, args := range [][]any{
+		{`1.3.06`, RejectLeadingZeros()},
+		{`01.3.6`, RejectLeadingZeros()},
+		{1, `03`, RejectLeadingZeros()},
+	} {
+		if _, err = NewDotNotation(args...); err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nothing", t.Name(), idx)
+		}
+	}
+}
+
+func ExampleWithMaxArcDigits() {
+	_, err := NewDotNotation(`2.999.123456789012`, WithMaxArcDigits(10))
+	fmt.Println(err)
+	// Output: Arc bears 12 digits, exceeding the maximum of 10
+}
+
+func TestParseOptions_maxDigits(t *testing.T) {
+	long := strings.Repeat(`9`, DefaultMaxArcDigits+1)
+	limit := strings.Repeat(`9`, DefaultMaxArcDigits)
+	padded := strings.Repeat(`0`, DefaultMaxArcDigits) + `7`
+
+	for idx, tc := range []struct {
+		parse func() error
+		ok    bool
+	}{
+		{func() (err error) { _, err = NewDotNotation(`2.999.` + limit); return }, true},
+		{func() (err error) { _, err = NewDotNotation(`2.999.` + padded); return }, true},
+		{func() (err error) { _, err = NewDotNotation(`2.999.` + long); return }, false},
+		{func() (err error) { _, err = NewDotNotation(`2.999.`+long, WithMaxArcDigits(0)); return }, true},
+		{func() (err error) { _, err = NewDotNotation(`2.999.12345`, WithMaxArcDigits(4)); return }, false},
+		{func() (err error) { _, err = NewNumberForm(long); return }, false},
+		{func() (err error) { _, err = NewNumberForm(long, WithMaxArcDigits(-1)); return }, true},
+		{func() (err error) {
+			_, err = NewASN1Notation(`{joint-iso-itu-t(2) example(999) x(` + long + `)}`)
+			return
+		}, false},
+		{func() (err error) { return NewBulkParser().Parse(`2.999.` + long) }, false},
+		{func() (err error) { return NewBulkParser(WithMaxArcDigits(0)).Parse(`2.999.` + long) }, true},
+	} {
+		if err := tc.parse(); (err == nil) != tc.ok {
+			t.Errorf("%s[%d] failed: want success %t, got error %v", t.Name(), idx, tc.ok, err)
+		}
+	}
+
+	// Any OID parsed within the default limits must survive an
+	// encoding round trip with the default decoding limits.
+	for _, s := range []string{`2.` + limit, `2.25.` + limit} {
+		var got DotNotation
+		if dot, err := NewDotNotation(s); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if b, err := dot.Encode(); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if err = got.Decode(b); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if got.String() != s {
+			t.Errorf("%s failed: want %s, got %s", t.Name(), s, got)
+		}
+	}
+}
+
+func ExampleAllowWhitespace() {
+	dot, err := NewDotNotation(" 1.3.6.1.4.1.56521\n", AllowWhitespace())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(dot)
+	// Output: 1.3.6.1.4.1.56521
+}
+
+func TestParseOptions_whitespace(t *testing.T) {
+	for _, input := range []string{
+		` 1.3.6.1 `,
+		"1.3.6.1\r\n",
+		"\t1 . 3 .6. 1",
+	} {
+		if _, err := NewDotNotation(input); err == nil {
+			t.Errorf("%s failed: strict parse of '%s' succeeded unexpectedly", t.Name(), input)
+		}
+
+		dot, err := NewDotNotation(input, AllowWhitespace())
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if dot.String() != `1.3.6.1` {
+			t.Errorf("%s failed: want '1.3.6.1', got '%s'", t.Name(), dot)
+		}
+	}
+
+	for idx, args := range [][]any{
+		{`1.3 6.1`, AllowWhitespace()},
+		{`1.3. .1`, AllowWhitespace()},
+		{1, `3 6`, AllowWhitespace()},
+	} {
+		if _, err := NewDotNotation(args...); err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nothing", t.Name(), idx)
+		}
+	}
+
+	if dot, err := NewDotNotation(1, ` 3 `, AllowWhitespace()); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if dot.String() != `1.3` {
+		t.Errorf("%s failed: want '1.3', got '%s'", t.Name(), dot)
+	}
+}
+
+func ExampleWithMaxSubidentifierOctets() {
+	// 2.25 followed by a 128-bit arc, which requires
+	// nineteen (19) subidentifier octets.
+	dot, _ := NewDotNotation(`2.25.340282366920938463463374607431768211455`)
+	b, _ := dot.Encode()
+
+	var out DotNotation
+	err := out.Decode(b, WithMaxSubidentifierOctets(16))
+	fmt.Println(err)
+	// Output: Subidentifier exceeds maximum of 16 octets
+}
+
+func TestEncodingOptions_limits(t *testing.T) {
+	// Build an OID whose encoded contents exceed 127 octets,
+	// requiring long-form length octets.
+	arcs := []any{`1`, `3`, `6`, `1`, `4`, `1`, `56521`}
+	for i := 0; i < 100; i++ {
+		arcs = append(arcs, 999)
+	}
+	dot, err := NewDotNotation(arcs...)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	b, err := dot.Encode()
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if b[1] != 0x81 || int(b[2]) != len(b)-3 {
+		t.Fatalf("%s failed: bad long-form length octets %#x", t.Name(), b[:3])
+	}
+
+	var out DotNotation
+	if err = out.Decode(b); err != nil || out.String() != dot.String() {
+		t.Errorf("%s failed: round trip failed: %v", t.Name(), err)
+	} else if err = CheckEncoding(b); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	}
+
+	short := WithMaxContentLength(64)
+	if _, err = dot.Encode(short); err == nil {
+		t.Errorf("%s failed: expected encode error for content limit", t.Name())
+	} else if err = out.Decode(b, short); err == nil {
+		t.Errorf("%s failed: expected decode error for content limit", t.Name())
+	} else if err = CheckEncoding(b, short); err == nil {
+		t.Errorf("%s failed: expected check error for content limit", t.Name())
+	} else if _, _, err = DecodeNext(b, short); err == nil {
+		t.Errorf("%s failed: expected DecodeNext error for content limit", t.Name())
+	}
+
+	// The default limit applies to encoding, lest Decode reject
+	// the output of Encode.
+	for i := 0; i < DefaultMaxContentLength/2; i++ {
+		arcs = append(arcs, 999)
+	}
+	huge, _ := NewDotNotation(arcs...)
+	if _, err = huge.Encode(); err == nil {
+		t.Errorf("%s failed: expected encode error for default content limit", t.Name())
+	} else if b, err = huge.Encode(WithMaxContentLength(0)); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if err = out.Decode(b, WithMaxContentLength(0)); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	}
+
+	// A hostile encoding claiming a four (4) octet length
+	// must be rejected without regard for the actual data.
+	hostile := []byte{0x06, 0x84, 0x7F, 0xFF, 0xFF, 0xFF, 0x2B}
+	if err = out.Decode(hostile); err == nil {
+		t.Errorf("%s failed: hostile length accepted", t.Name())
+	} else if sc := NewScanner(bytes.NewReader(hostile)); sc.Scan() || sc.Err() == nil {
+		t.Errorf("%s failed: scanner accepted hostile length", t.Name())
+	}
+
+	// A single subidentifier bearing 100 continuation octets.
+	long := append([]byte{0x06, 0x66, 0x2B}, bytes.Repeat([]byte{0xFF}, 100)...)
+	long = append(long, 0x7F)
+	long[1] = byte(len(long) - 2)
+	if err = out.Decode(long); err == nil {
+		t.Errorf("%s failed: oversized subidentifier accepted", t.Name())
+	} else if err = CheckEncoding(long); err == nil {
+		t.Errorf("%s failed: oversized subidentifier passed check", t.Name())
+	} else if err = out.Decode(long, WithMaxSubidentifierOctets(0)); err != nil {
+		t.Errorf("%s failed: unlimited decode failed: %v", t.Name(), err)
+	}
+
+	for _, bogus := range []EncodingOption{WithMaxContentLength(-1), WithMaxSubidentifierOctets(-1)} {
+		if _, err = dot.Encode(bogus); err == nil {
+			t.Errorf("%s failed: expected error for negative limit", t.Name())
+		}
+	}
+}
+
+func ExampleAllowSubMinimal() {
+	root, _ := parseArcKey(`2`)
+	b, err := root.Encode(AllowSubMinimal())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	var dot DotNotation
+	if err = dot.Decode(b, AllowSubMinimal()); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%#x %s\n", b, dot)
+	// Output: 0x060150 2
+}
+
+func TestAllowSubMinimal(t *testing.T) {
+	for key, want := range map[string][]byte{
+		`0`: {0x06, 0x01, 0x00},
+		`1`: {0x06, 0x01, 0x28},
+		`2`: {0x06, 0x01, 0x50},
+	} {
+		root, _ := parseArcKey(key)
+		if _, err := root.Encode(); err == nil {
+			t.Errorf("%s failed: sub-minimal %s encoded by default", t.Name(), key)
+		}
+
+		b, err := root.Encode(AllowSubMinimal())
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			continue
+		} else if !bytes.Equal(b, want) {
+			t.Errorf("%s failed: want %#x, got %#x", t.Name(), want, b)
+		}
+
+		if d, _, err := DecodeNext(b, AllowSubMinimal()); err != nil || d.String() != key {
+			t.Errorf("%s failed: want %s, got %s (%v)", t.Name(), key, d, err)
+		}
+
+		// Conformant decoding remains the default.
+		var d DotNotation
+		if err = d.Decode(b); err != nil || d.String() != key+`.0` {
+			t.Errorf("%s failed: want %s.0, got %s (%v)", t.Name(), key, d, err)
+		}
+	}
+
+	// Only single-octet encodings bearing a zero second
+	// arc are affected.
+	var d DotNotation
+	if err := d.Decode([]byte{0x06, 0x02, 0x2b, 0x06}, AllowSubMinimal()); err != nil || d.String() != `1.3.6` {
+		t.Errorf("%s failed: want 1.3.6, got %s (%v)", t.Name(), d, err)
+	}
+
+	root, _ := parseArcKey(`2`)
+	root[0] = NumberForm(*big.NewInt(3))
+	if _, err := root.Encode(AllowSubMinimal()); err == nil {
+		t.Errorf("%s failed: invalid root arc encoded", t.Name())
+	}
+}
@@ -0,0 +1,185 @@
+package objectid
+
+/*
+ra.go implements registration authority metadata per Rec. ITU-T X.660.
+*/
+
+import "time"
+
+/*
+RegistrationStatus describes the state of a registration, as recorded by
+its [RegistrationAuthority].
+*/
+type RegistrationStatus uint8
+
+const (
+	StatusUnspecified RegistrationStatus = iota // status is not known
+	StatusActive                                // arc is allocated and in use
+	StatusInactive                              // arc is allocated but no longer in use
+	StatusWithdrawn                             // allocation has been withdrawn
+)
+
+var registrationStatuses = [...]string{
+	StatusUnspecified: `unspecified`,
+	StatusActive:      `active`,
+	StatusInactive:    `inactive`,
+	StatusWithdrawn:   `withdrawn`,
+}
+
+/*
+String returns the string representation of the receiver (e.g.:
+"active"), or a zero string if the receiver is invalid.
+*/
+func (r RegistrationStatus) String() (s string) {
+	if int(r) < len(registrationStatuses) {
+		s = registrationStatuses[r]
+	}
+
+	return
+}
+
+/*
+parseRegistrationStatus returns the [RegistrationStatus] whose string
+representation is s, or [StatusUnspecified] if s is not recognized.
+*/
+func parseRegistrationStatus(s string) RegistrationStatus {
+	for i := 0; i < len(registrationStatuses); i++ {
+		if eq(s, registrationStatuses[i]) {
+			return RegistrationStatus(i)
+		}
+	}
+
+	return StatusUnspecified
+}
+
+/*
+RegistrationAuthority contains the metadata of the authority responsible
+for an arc, as described by Rec. ITU-T X.660. An authority attached to a
+[Record] governs the arc of the record and, per the delegation model of
+X.660, all arcs beneath it which do not bear an authority of their own.
+See [Registry.Authority] for details.
+*/
+type RegistrationAuthority struct {
+	// Name contains the name of the authority (required).
+	Name string
+
+	// Contact contains a free-form point of contact for the authority,
+	// such as an email address or postal address.
+	Contact string
+
+	// URL contains the address of a resource published by the
+	// authority, such as a registration policy or listing.
+	URL string
+
+	// Status contains the status of the registration.
+	Status RegistrationStatus
+
+	// Created contains the time at which the registration was made.
+	Created time.Time
+
+	// Modified contains the time at which the registration was last
+	// modified.
+	Modified time.Time
+}
+
+/*
+validate returns an error if the receiver is unsuitable for registration.
+*/
+func (r RegistrationAuthority) validate() (err error) {
+	if len(r.Name) == 0 {
+		err = errorf("%T bears no name", r)
+	} else if len(r.Status.String()) == 0 {
+		err = errorf("%T bears an invalid status (%d)", r, r.Status)
+	} else if !r.Created.IsZero() && !r.Modified.IsZero() && r.Modified.Before(r.Created) {
+		err = errorf("%T modification time precedes creation time", r)
+	}
+
+	return
+}
+
+/*
+clone returns an independent copy of the receiver, or nil if the receiver
+is nil.
+*/
+func (r *RegistrationAuthority) clone() (c *RegistrationAuthority) {
+	if r != nil {
+		ra := *r
+		c = &ra
+	}
+
+	return
+}
+
+/*
+Authority returns the [RegistrationAuthority] governing the arc identified
+by dot, which can be a string or [DotNotation], alongside the [DotNotation]
+of the record to which it is attached and a Boolean value indicative of a
+successful lookup. The record at dot is consulted first, followed by each
+of its ancestors in turn, such that the nearest delegated authority is
+returned.
+*/
+func (r *Registry) Authority(dot any) (ra RegistrationAuthority, at DotNotation, found bool) {
+	d, ok := registryDot(dot)
+	if !ok {
+		return
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for n := d.Len(); n > 0 && !found; n-- {
+		if node := r.root.find(d[:n]); node != nil && node.entry.authority != nil {
+			ra, at, found = *node.entry.authority, d[:n:n].clone(), true
+		}
+	}
+
+	return
+}
+
+/*
+fields returns the binary registry metadata fields describing the
+receiver. Zero values are omitted, save for the name.
+*/
+func (r RegistrationAuthority) fields() (f []registryField) {
+	f = append(f, registryField{fieldRAName, r.Name})
+	if len(r.Contact) > 0 {
+		f = append(f, registryField{fieldRAContact, r.Contact})
+	}
+	if len(r.URL) > 0 {
+		f = append(f, registryField{fieldRAURL, r.URL})
+	}
+	if r.Status != StatusUnspecified {
+		f = append(f, registryField{fieldRAStatus, r.Status.String()})
+	}
+	if !r.Created.IsZero() {
+		f = append(f, registryField{fieldRACreated, r.Created.UTC().Format(time.RFC3339Nano)})
+	}
+	if !r.Modified.IsZero() {
+		f = append(f, registryField{fieldRAModified, r.Modified.UTC().Format(time.RFC3339Nano)})
+	}
+
+	return
+}
+
+/*
+setField assigns value to the field of the receiver identified by the
+binary registry metadata tag, returning an error if value is invalid.
+*/
+func (r *RegistrationAuthority) setField(tag byte, value string) (err error) {
+	switch tag {
+	case fieldRAName:
+		r.Name = value
+	case fieldRAContact:
+		r.Contact = value
+	case fieldRAURL:
+		r.URL = value
+	case fieldRAStatus:
+		r.Status = parseRegistrationStatus(value)
+	case fieldRACreated:
+		r.Created, err = time.Parse(time.RFC3339Nano, value)
+	case fieldRAModified:
+		r.Modified, err = time.Parse(time.RFC3339Nano, value)
+	}
+
+	return
+}
@@ -0,0 +1,98 @@
+package objectid
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func ExampleRegistry_Authority() {
+	reg := NewRegistry()
+	_ = reg.Register(Record{
+		Dot:        mustDot(`1.3.6.1.4.1.56521`),
+		Identifier: `example`,
+		Authority: &RegistrationAuthority{
+			Name:   `Example Corp.`,
+			URL:    `https://oid.example.com`,
+			Status: StatusActive,
+		},
+	})
+	_ = reg.Register(Record{Dot: mustDot(`1.3.6.1.4.1.56521.999`)})
+
+	ra, at, found := reg.Authority(`1.3.6.1.4.1.56521.999`)
+	fmt.Println(ra.Name, at, ra.Status, found)
+	// Output: Example Corp. 1.3.6.1.4.1.56521 active true
+}
+
+func TestRegistry_Authority(t *testing.T) {
+	created := time.Date(2019, 7, 1, 12, 0, 0, 0, time.UTC)
+	ra := RegistrationAuthority{
+		Name:     `Example Corp.`,
+		Contact:  `oid@example.com`,
+		URL:      `https://oid.example.com`,
+		Status:   StatusActive,
+		Created:  created,
+		Modified: created.Add(48 * time.Hour),
+	}
+
+	reg := newTestRegistry(t)
+	if err := reg.Register(Record{Dot: mustDot(`1.3.6.1.4.1.56521`), Authority: &ra}); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	// The registry retains its own copy.
+	ra.Name = `Changed`
+	got, at, found := reg.Authority(`1.3.6.1.4.1.56521.999.1`)
+	if !found || got.Name != `Example Corp.` || at.String() != `1.3.6.1.4.1.56521` {
+		t.Errorf("%s failed: unexpected authority %#v at %s (found: %t)", t.Name(), got, at, found)
+	} else if _, _, found = reg.Authority(`1.3.6.1.4.1.1`); found {
+		t.Errorf("%s failed: unexpected authority for undelegated arc", t.Name())
+	}
+	ra.Name = `Example Corp.`
+
+	for _, bad := range []RegistrationAuthority{
+		{},
+		{Name: `x`, Status: RegistrationStatus(99)},
+		{Name: `x`, Created: created, Modified: created.Add(-time.Second)},
+	} {
+		if err := reg.Register(Record{Dot: mustDot(`2.999`), Authority: &bad}); err == nil {
+			t.Errorf("%s failed: expected error for %#v", t.Name(), bad)
+		}
+	}
+
+	// Binary round trip
+	var buf bytes.Buffer
+	if err := reg.Save(&buf); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+	loaded := NewRegistry()
+	if err := loaded.Load(&buf); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+	rec, _ := loaded.Lookup(`1.3.6.1.4.1.56521`)
+	if rec.Authority == nil || *rec.Authority != ra {
+		t.Errorf("%s failed: want %#v, got %#v", t.Name(), ra, rec.Authority)
+	} else if rec, _ = loaded.Lookup(`1.3.6.1.4.1`); rec.Authority != nil {
+		t.Errorf("%s failed: unexpected authority %#v", t.Name(), rec.Authority)
+	}
+
+	// LDIF export
+	var out strings.Builder
+	if err := reg.ExportLDIF(&out, `1.3.6.1.4.1.56521`, ``); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+	for _, line := range []string{
+		"currentAuthorityCommonName: Example Corp.\n",
+		"currentAuthorityEmail: oid@example.com\n",
+		"registrationURI: https://oid.example.com\n",
+		"registrationStatus: active\n",
+		"registrationCreated: 20190701120000Z\n",
+		"registrationModified: 20190703120000Z\n",
+	} {
+		if !strings.Contains(out.String(), line) {
+			t.Errorf("%s failed: LDIF lacks %q:\n%s", t.Name(), line, out.String())
+		}
+	}
+}
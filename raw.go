@@ -0,0 +1,88 @@
+package objectid
+
+/*
+raw.go provides interoperability with the encoding/asn1 package.
+*/
+
+import "encoding/asn1"
+
+/*
+RawValue returns an instance of [encoding/asn1.RawValue] populated with
+the class, tag and bytes produced by the [DotNotation.Encode] method,
+alongside an error.
+
+This allows OIDs bearing arcs that would overflow the native
+[encoding/asn1.ObjectIdentifier] type (e.g.: 2.25 UUID-based OIDs) to be
+embedded within structures marshaled using the [encoding/asn1] package.
+Simply declare the relevant struct field as [encoding/asn1.RawValue], and
+use [DotNotation.DecodeRawValue] following unmarshaling.
+
+Zero or more instances of [EncodingOption] may be provided. For instance,
+[WithImplicitTag] produces a context-specific value suitable for a field
+declared as "[n] IMPLICIT OBJECT IDENTIFIER".
+*/
+func (r DotNotation) RawValue(opts ...EncodingOption) (rv asn1.RawValue, err error) {
+	cfg := newEncodingConfig(opts...)
+	if err = cfg.err; err != nil {
+		return
+	}
+
+	var b []byte
+	if b, err = r.Encode(opts...); err != nil {
+		return
+	}
+
+	var content []byte
+	if content, _, err = readOIDTLV(b, cfg); err == nil {
+		rv = asn1.RawValue{
+			Class:     int(cfg.tag >> 6),
+			Tag:       int(cfg.tag & 0x1F),
+			Bytes:     content,
+			FullBytes: b,
+		}
+	}
+
+	return
+}
+
+/*
+DecodeRawValue returns an error following an attempt to decode rv, such
+as one populated by [encoding/asn1.Unmarshal], into the receiver instance.
+The receiver instance is reinitialized at runtime.
+
+The value must be primitive, and must either bear the universal OBJECT
+IDENTIFIER tag (6) or a context-specific tag, as would result from an
+implicitly tagged field. Zero or more instances of [EncodingOption] may
+be provided to adjust the limits described by the [DotNotation.Decode]
+method; any tag options are ignored, as the tag is conveyed by rv.
+*/
+func (r *DotNotation) DecodeRawValue(rv asn1.RawValue, opts ...EncodingOption) (err error) {
+	cfg := newEncodingConfig(opts...)
+	if err = cfg.err; err != nil {
+		return
+	}
+
+	switch {
+	case rv.IsCompound:
+		err = errorf("%T for OID must be primitive", rv)
+	case rv.Class == asn1.ClassUniversal && rv.Tag != asn1.TagOID:
+		err = errorf("Invalid ASN.1 Tag %d; want: %d", rv.Tag, asn1.TagOID)
+	case rv.Class != asn1.ClassUniversal && rv.Class != asn1.ClassContextSpecific:
+		err = errorf("Unsupported ASN.1 class %d for OID", rv.Class)
+	case len(rv.Bytes) == 0:
+		err = errorf("Zero length OID contents")
+	default:
+		err = cfg.checkContentLength(len(rv.Bytes))
+	}
+
+	if err != nil {
+		return
+	}
+
+	var d DotNotation
+	if d, err = decodeContent(rv.Bytes, cfg); err == nil {
+		*r = d
+	}
+
+	return
+}
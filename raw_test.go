@@ -0,0 +1,138 @@
+package objectid
+
+import (
+	"encoding/asn1"
+	"fmt"
+	"testing"
+)
+
+/*
+This example demonstrates the embedding of a UUID-based OID, which would
+overflow the [encoding/asn1.ObjectIdentifier] type, within a structure
+marshaled using the [encoding/asn1] package.
+*/
+func ExampleDotNotation_RawValue() {
+	dot, _ := NewDotNotation(`2.25.987895962269883002155146617097157934`)
+	rv, err := dot.RawValue()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	type wrapper struct {
+		Version int
+		Type    asn1.RawValue
+	}
+
+	b, err := asn1.Marshal(wrapper{Version: 1, Type: rv})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%d bytes", len(b))
+	// Output: 26 bytes
+}
+
+func TestDotNotation_RawValue(t *testing.T) {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	rv, err := dot.RawValue()
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	var oid asn1.ObjectIdentifier
+	if _, err = asn1.Unmarshal(rv.FullBytes, &oid); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if oid.String() != dot.String() {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), dot, oid)
+	}
+
+	var bogus DotNotation
+	if _, err = bogus.RawValue(); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+}
+
+/*
+This example demonstrates the round trip of a UUID-based OID through a
+structure marshaled and unmarshaled using the [encoding/asn1] package.
+*/
+func ExampleDotNotation_DecodeRawValue() {
+	type wrapper struct {
+		Version int
+		Type    asn1.RawValue
+	}
+
+	dot, _ := NewDotNotation(`2.25.987895962269883002155146617097157934`)
+	rv, _ := dot.RawValue()
+	b, err := asn1.Marshal(wrapper{Version: 1, Type: rv})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	var w wrapper
+	if _, err = asn1.Unmarshal(b, &w); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	var out DotNotation
+	if err = out.DecodeRawValue(w.Type); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(out)
+	// Output: 2.25.987895962269883002155146617097157934
+}
+
+func TestDotNotation_DecodeRawValue(t *testing.T) {
+	type wrapper struct {
+		Name string
+		Type asn1.RawValue `asn1:"tag:3"`
+	}
+
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521.999`)
+	rv, err := dot.RawValue(WithImplicitTag(3))
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if rv.Class != asn1.ClassContextSpecific || rv.Tag != 3 {
+		t.Fatalf("%s failed: bad class/tag %d/%d", t.Name(), rv.Class, rv.Tag)
+	}
+
+	b, err := asn1.Marshal(wrapper{Name: `test`, Type: rv})
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	var w wrapper
+	if _, err = asn1.Unmarshal(b, &w); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	var out DotNotation
+	if err = out.DecodeRawValue(w.Type); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if out.String() != dot.String() {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), dot, out)
+	}
+
+	for _, bogus := range []asn1.RawValue{
+		{Class: asn1.ClassUniversal, Tag: asn1.TagInteger, Bytes: []byte{0x01}},
+		{Class: asn1.ClassUniversal, Tag: asn1.TagOID},
+		{Class: asn1.ClassApplication, Tag: 1, Bytes: []byte{0x2B}},
+		{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: []byte{0x2B}},
+		{Class: asn1.ClassUniversal, Tag: asn1.TagOID, Bytes: []byte{0x2B, 0x86}},
+	} {
+		if err = out.DecodeRawValue(bogus); err == nil {
+			t.Errorf("%s failed: bogus %T decoded without error", t.Name(), bogus)
+		}
+	}
+
+	if _, err = dot.RawValue(WithImplicitTag(31)); err == nil {
+		t.Errorf("%s failed: expected error for bogus option", t.Name())
+	}
+}
@@ -0,0 +1,276 @@
+package objectid
+
+/*
+regbin.go implements the compact binary serialization of a Registry.
+*/
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"math/big"
+)
+
+/*
+Binary registry format constants.
+
+The format consists of a header, followed by one entry per record in the
+order produced by [Registry.Records]:
+
+	header:   magic ("OIDR") | version (1 octet) | record count (uvarint)
+	entry:    shared arcs (uvarint) | new arcs (uvarint) | arc* | metadata
+	arc:      octet length (uvarint) | big-endian unsigned magnitude
+	metadata: field count (uvarint) | field*
+	field:    tag (1 octet) | octet length (uvarint) | value
+
+Each entry only stores the arcs by which it differs from its predecessor,
+as each record shares its leading arcs ("shared arcs") with the previous
+record. Metadata fields bearing unknown tags are skipped when loading,
+allowing future versions to add fields without breaking older readers.
+*/
+const (
+	registryMagic   = "OIDR"
+	registryVersion = 1
+)
+
+const (
+	fieldIdentifier byte = iota + 1
+	fieldDescription
+	fieldRAName
+	fieldRAContact
+	fieldRAURL
+	fieldRAStatus
+	fieldRACreated
+	fieldRAModified
+)
+
+/*
+registryField is a single tagged metadata field of a binary registry entry.
+*/
+type registryField struct {
+	tag   byte
+	value string
+}
+
+/*
+Save writes the contents of the receiver to w using a compact, versioned
+binary format, returning an error if the operation fails. See [Registry.Load]
+for the inverse operation.
+*/
+func (r *Registry) Save(w io.Writer) (err error) {
+	bw := bufio.NewWriter(w)
+	recs := r.Records()
+
+	var buf []byte
+	buf = append(buf, registryMagic...)
+	buf = append(buf, registryVersion)
+	buf = binary.AppendUvarint(buf, uint64(len(recs)))
+
+	var prev DotNotation
+	for i := 0; i < len(recs); i++ {
+		buf = appendRecord(buf, prev, recs[i])
+		prev = recs[i].Dot
+
+		if len(buf) >= 4096 {
+			if _, err = bw.Write(buf); err != nil {
+				return
+			}
+			buf = buf[:0]
+		}
+	}
+
+	if _, err = bw.Write(buf); err == nil {
+		err = bw.Flush()
+	}
+
+	return
+}
+
+/*
+appendRecord appends the binary encoding of rec to buf, relative to the
+[DotNotation] of the previously encoded record prev.
+*/
+func appendRecord(buf []byte, prev DotNotation, rec Record) []byte {
+	shared := sharedArcs(prev, rec.Dot)
+	buf = binary.AppendUvarint(buf, uint64(shared))
+	buf = binary.AppendUvarint(buf, uint64(rec.Dot.Len()-shared))
+	for i := shared; i < rec.Dot.Len(); i++ {
+		b := rec.Dot[i].cast().Bytes()
+		buf = binary.AppendUvarint(buf, uint64(len(b)))
+		buf = append(buf, b...)
+	}
+
+	var fields []registryField
+	if len(rec.Identifier) > 0 {
+		fields = append(fields, registryField{fieldIdentifier, rec.Identifier})
+	}
+	if len(rec.Description) > 0 {
+		fields = append(fields, registryField{fieldDescription, rec.Description})
+	}
+	if ra := rec.Authority; ra != nil {
+		fields = append(fields, ra.fields()...)
+	}
+
+	buf = binary.AppendUvarint(buf, uint64(len(fields)))
+	for i := 0; i < len(fields); i++ {
+		buf = append(buf, fields[i].tag)
+		buf = binary.AppendUvarint(buf, uint64(len(fields[i].value)))
+		buf = append(buf, fields[i].value...)
+	}
+
+	return buf
+}
+
+/*
+sharedArcs returns the number of leading arcs shared by a and b.
+*/
+func sharedArcs(a, b DotNotation) (n int) {
+	for n < a.Len() && n < b.Len() && a[n].cast().Cmp(b[n].cast()) == 0 {
+		n++
+	}
+
+	return
+}
+
+/*
+Load reads a registry previously written by [Registry.Save] from rd into
+the receiver, returning an error if the operation fails. Records loaded
+replace any existing records bearing the same [DotNotation]; upon error,
+the receiver is left unmodified.
+*/
+func (r *Registry) Load(rd io.Reader) error {
+	return r.LoadContext(context.Background(), rd)
+}
+
+/*
+LoadContext is the same as [Registry.Load], except that loading ceases
+with the error of ctx once ctx is done, leaving the receiver unmodified.
+*/
+func (r *Registry) LoadContext(ctx context.Context, rd io.Reader) (err error) {
+	br := bufio.NewReader(rd)
+
+	hdr := make([]byte, len(registryMagic)+1)
+	if _, err = io.ReadFull(br, hdr); err != nil {
+		err = errorf("Failed to read registry header: %v", err)
+		return
+	} else if string(hdr[:len(registryMagic)]) != registryMagic {
+		err = errorf("Invalid registry magic")
+		return
+	} else if hdr[len(registryMagic)] != registryVersion {
+		err = errorf("Unsupported registry version %d", hdr[len(registryMagic)])
+		return
+	}
+
+	var count uint64
+	if count, err = binary.ReadUvarint(br); err != nil {
+		return
+	}
+
+	var (
+		prev DotNotation
+		recs []Record
+	)
+
+	for i := uint64(0); i < count; i++ {
+		var rec Record
+		if err = canceled(ctx); err != nil {
+			return
+		} else if rec, err = readRecord(br, prev); err != nil {
+			err = errorf("Record %d: %v", i, err)
+			return
+		} else if err = rec.validate(); err != nil {
+			err = errorf("Record %d: %v", i, err)
+			return
+		}
+		recs = append(recs, rec)
+		prev = rec.Dot
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := 0; i < len(recs); i++ {
+		r.store(recs[i])
+	}
+
+	return
+}
+
+/*
+readRecord reads a single record from br, relative to the [DotNotation]
+of the previously decoded record prev.
+*/
+func readRecord(br *bufio.Reader, prev DotNotation) (rec Record, err error) {
+	var shared, fresh uint64
+	if shared, err = binary.ReadUvarint(br); err != nil {
+		return
+	} else if shared > uint64(prev.Len()) {
+		err = errorf("Shared arc count %d exceeds previous record length", shared)
+		return
+	} else if fresh, err = binary.ReadUvarint(br); err != nil {
+		return
+	}
+
+	rec.Dot = make(DotNotation, 0, int(shared)+1)
+	rec.Dot = append(rec.Dot, prev[:shared]...)
+	for i := uint64(0); i < fresh; i++ {
+		var b []byte
+		if b, err = readBlock(br); err != nil {
+			return
+		}
+		rec.Dot = append(rec.Dot, NumberForm(*big.NewInt(0).SetBytes(b)))
+	}
+
+	var nfields uint64
+	if nfields, err = binary.ReadUvarint(br); err != nil {
+		return
+	}
+
+	for i := uint64(0); i < nfields; i++ {
+		var (
+			tag byte
+			b   []byte
+		)
+		if tag, err = br.ReadByte(); err != nil {
+			return
+		} else if b, err = readBlock(br); err != nil {
+			return
+		}
+
+		switch tag {
+		case fieldIdentifier:
+			rec.Identifier = string(b)
+		case fieldDescription:
+			rec.Description = string(b)
+		case fieldRAName, fieldRAContact, fieldRAURL, fieldRAStatus, fieldRACreated, fieldRAModified:
+			if rec.Authority == nil {
+				rec.Authority = &RegistrationAuthority{}
+			}
+			if err = rec.Authority.setField(tag, string(b)); err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+/*
+readBlock reads a uvarint length-prefixed block of octets from br.
+*/
+func readBlock(br *bufio.Reader) (b []byte, err error) {
+	var length uint64
+	if length, err = binary.ReadUvarint(br); err != nil {
+		return
+	} else if length > uint64(1<<20) {
+		err = errorf("Block length %d exceeds maximum", length)
+		return
+	}
+
+	b = make([]byte, length)
+	if _, err = io.ReadFull(br, b); err != nil {
+		err = errorf("Truncated registry block: %v", err)
+	}
+
+	return
+}
@@ -0,0 +1,98 @@
+package objectid
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleRegistry_Save() {
+	reg := NewRegistry()
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	_ = reg.Register(Record{Dot: *dot, Identifier: `example`})
+
+	var buf bytes.Buffer
+	if err := reg.Save(&buf); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	loaded := NewRegistry()
+	if err := loaded.Load(&buf); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	rec, _ := loaded.Lookup(dot)
+	fmt.Printf("%s: %s", rec.Dot, rec.Identifier)
+	// Output: 1.3.6.1.4.1.56521: example
+}
+
+func TestRegistry_SaveLoad(t *testing.T) {
+	reg := newTestRegistry(t)
+	big, _ := NewDotNotation(`2.25.987895962269883002155146617097157934`)
+	_ = reg.Register(Record{Dot: *big, Description: `UUID-based`})
+
+	var buf bytes.Buffer
+	if err := reg.Save(&buf); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+	raw := buf.Bytes()
+
+	loaded := NewRegistry()
+	if err := loaded.Load(bytes.NewReader(raw)); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	want, got := reg.Records(), loaded.Records()
+	if len(want) != len(got) {
+		t.Errorf("%s failed: want %d records, got %d", t.Name(), len(want), len(got))
+		return
+	}
+	for i := 0; i < len(want); i++ {
+		if want[i].Dot.String() != got[i].Dot.String() ||
+			want[i].Identifier != got[i].Identifier ||
+			want[i].Description != got[i].Description {
+			t.Errorf("%s failed: want %#v, got %#v", t.Name(), want[i], got[i])
+		}
+	}
+
+	for idx, bogus := range [][]byte{
+		nil,
+		[]byte(`XXXX`),
+		[]byte("OIDR\x02\x00"),
+		raw[:len(raw)-3],
+		append([]byte("OIDR\x01\x01\x05"), raw[6:]...),
+	} {
+		if err := NewRegistry().Load(bytes.NewReader(bogus)); err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nothing", t.Name(), idx)
+		}
+	}
+}
+
+func TestRegistry_LoadContext(t *testing.T) {
+	var buf bytes.Buffer
+	if err := newTestRegistry(t).Save(&buf); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	reg := NewRegistry()
+	if err := reg.LoadContext(ctx, bytes.NewReader(buf.Bytes())); !errors.Is(err, context.Canceled) {
+		t.Errorf("%s failed: want context.Canceled, got %v", t.Name(), err)
+	} else if reg.Len() != 0 {
+		t.Errorf("%s failed: registry modified upon cancellation", t.Name())
+	}
+
+	if err := reg.LoadContext(context.Background(), &buf); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if reg.Len() != 6 {
+		t.Errorf("%s failed: want 6 records, got %d", t.Name(), reg.Len())
+	}
+}
@@ -0,0 +1,521 @@
+package objectid
+
+/*
+regfile.go implements the RegistryFile type, a file-backed persistent
+store for Registry instances.
+*/
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"os"
+	"sync"
+)
+
+/*
+Registry log format constants.
+
+A registry log consists of a header, followed by one entry per mutation
+in the order in which each was performed:
+
+	header:  magic ("OIDL") | version (1 octet)
+	entry:   operation (1 octet) | payload length (uvarint) | payload | checksum
+	payload: a single entry of the binary registry format (see [Registry.Save]),
+	         encoded without reference to any previous record
+	batch:   one or more of operation (1 octet) | payload length (uvarint) | payload
+
+The checksum is the big-endian CRC-32 (IEEE) of the operation octet and
+the payload. The payload of a batch entry, as written by
+[RegistryFile.Apply], bears the unchecksummed register and unregister
+operations of the batch, which are replayed as a single [Registry.Apply].
+An incomplete final entry, such as may result from a crash during a write,
+is discarded when the log is opened.
+*/
+const (
+	registryLogMagic   = "OIDL"
+	registryLogVersion = 1
+
+	maxLogEntryLength = 1 << 20 // maximum payload length of a register or unregister entry
+	maxLogBatchLength = 1 << 26 // maximum payload length of a batch entry
+)
+
+const (
+	logRegister byte = iota + 1
+	logUnregister
+	logBatch
+)
+
+/*
+RegistryFile is a [Registry] persisted to a single append-only log file,
+allowing long-lived registries to survive restarts without a full import.
+Each call of [RegistryFile.Register], [RegistryFile.Unregister] or
+[RegistryFile.Apply] appends an entry to the log, which is replayed upon
+[OpenRegistryFile].
+
+Each entry is committed to stable storage before the underlying [Registry]
+is modified, such that the registry never holds a record which the log
+does not. Use [RegistryFile.Compact] to discard superseded entries.
+
+Instances of this type are safe for concurrent use, and should be created
+using the [OpenRegistryFile] function.
+*/
+type RegistryFile struct {
+	mu   sync.Mutex
+	path string
+	reg  *Registry
+	f    *os.File
+	bw   *bufio.Writer
+}
+
+/*
+OpenRegistryFile opens the registry log at path, creating it if it does
+not exist, and returns an instance of *[RegistryFile] bearing its replayed
+contents alongside an error.
+
+An error is returned if the file is not a registry log, or if any entry
+fails its checksum. An incomplete final entry is truncated.
+*/
+func OpenRegistryFile(path string) (*RegistryFile, error) {
+	return OpenRegistryFileContext(context.Background(), path)
+}
+
+/*
+OpenRegistryFileContext is the same as [OpenRegistryFile], except that
+the replay of the log ceases with the error of ctx once ctx is done, in
+which case the file is closed and left unmodified.
+*/
+func OpenRegistryFileContext(ctx context.Context, path string) (r *RegistryFile, err error) {
+	var f *os.File
+	if f, err = os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644); err != nil {
+		return
+	}
+
+	reg := NewRegistry()
+	if err = replayRegistryLog(ctx, f, reg); err != nil {
+		f.Close()
+		return
+	}
+
+	r = &RegistryFile{path: path, reg: reg, f: f, bw: bufio.NewWriter(f)}
+
+	return
+}
+
+/*
+Registry returns the underlying *[Registry] of the receiver, which may be
+used for lookups and searches. Mutations made directly to the returned
+instance are not persisted.
+*/
+func (r *RegistryFile) Registry() *Registry {
+	return r.reg
+}
+
+/*
+Register adds rec to the receiver in the manner of [Registry.Register],
+and appends the operation to the log. The entry is committed to stable
+storage before rec is registered.
+*/
+func (r *RegistryFile) Register(rec Record) (err error) {
+	if err = rec.validate(); err != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err = r.reg.CheckChild(rec.Dot); err != nil {
+		return
+	} else if err = r.commit(appendLogEntry(nil, logRegister, rec)); err == nil {
+		err = r.reg.Register(rec)
+	}
+
+	return
+}
+
+/*
+Unregister removes the [Record] registered for dot in the manner of
+[Registry.Unregister], and appends the operation to the log. The entry is
+committed to stable storage before the record is removed. A Boolean value
+indicative of whether a record was removed is returned alongside an error.
+*/
+func (r *RegistryFile) Unregister(dot any) (removed bool, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, found := r.reg.Lookup(dot)
+	if !found {
+		return
+	}
+
+	if err = r.commit(appendLogEntry(nil, logUnregister, Record{Dot: rec.Dot})); err == nil {
+		removed = r.reg.Unregister(rec.Dot)
+	}
+
+	return
+}
+
+/*
+Flush writes any buffered entries to the log file, and commits the file
+to stable storage.
+*/
+func (r *RegistryFile) Flush() (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.flush()
+}
+
+/*
+Close flushes the receiver and closes the log file. The receiver may not
+be used for further writes.
+*/
+func (r *RegistryFile) Close() (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.f == nil {
+		return errorf("%T is closed", r)
+	}
+
+	err = r.flush()
+	if cerr := r.f.Close(); err == nil {
+		err = cerr
+	}
+	r.f, r.bw = nil, nil
+
+	return
+}
+
+/*
+Compact rewrites the log such that it contains a single entry for each
+record presently held, discarding superseded and removed entries. The
+new log is written alongside the old, and replaces it atomically.
+*/
+func (r *RegistryFile) Compact() error {
+	return r.CompactContext(context.Background())
+}
+
+/*
+CompactContext is the same as [RegistryFile.Compact], except that the
+rewrite is abandoned with the error of ctx once ctx is done, in which case
+the existing log remains in use.
+*/
+func (r *RegistryFile) CompactContext(ctx context.Context) (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err = r.flush(); err != nil {
+		return
+	}
+
+	tmp := r.path + `.compact`
+	var f *os.File
+	if f, err = os.OpenFile(tmp, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644); err != nil {
+		return
+	}
+
+	bw := bufio.NewWriter(f)
+	bw.WriteString(registryLogMagic)
+	bw.WriteByte(registryLogVersion)
+
+	recs := r.reg.Records()
+	for i := 0; i < len(recs) && err == nil; i++ {
+		if err = canceled(ctx); err == nil {
+			_, err = bw.Write(appendLogEntry(nil, logRegister, recs[i]))
+		}
+	}
+
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if err == nil {
+		err = os.Rename(tmp, r.path)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return
+	}
+
+	r.f.Close()
+	r.f, r.bw = f, bufio.NewWriter(f)
+	_, err = f.Seek(0, io.SeekEnd)
+
+	return
+}
+
+/*
+commit appends the encoded log entry to the log file and commits it to
+stable storage. Should either fail, any portion of entry already written
+is truncated, such that no later entry may follow a torn one. The caller
+must hold the lock.
+*/
+func (r *RegistryFile) commit(entry []byte) (err error) {
+	if r.f == nil {
+		return errorf("%T is closed", r)
+	}
+
+	var offset int64
+	if offset, err = r.f.Seek(0, io.SeekCurrent); err != nil {
+		return
+	}
+
+	if _, err = r.bw.Write(entry); err == nil {
+		err = r.flush()
+	}
+
+	if err != nil {
+		r.bw.Reset(r.f)
+		if r.f.Truncate(offset) == nil {
+			r.f.Seek(offset, io.SeekStart)
+		}
+	}
+
+	return
+}
+
+func (r *RegistryFile) flush() (err error) {
+	if r.f == nil {
+		return errorf("%T is closed", r)
+	}
+
+	if err = r.bw.Flush(); err == nil {
+		err = r.f.Sync()
+	}
+
+	return
+}
+
+/*
+appendLogEntry appends the registry log encoding of the operation op upon
+rec to buf.
+*/
+func appendLogEntry(buf []byte, op byte, rec Record) []byte {
+	return appendLogFrame(buf, op, appendRecord(nil, nil, rec))
+}
+
+/*
+appendLogFrame appends the log entry bearing the operation op and the
+encoded payload, followed by its checksum, to buf.
+*/
+func appendLogFrame(buf []byte, op byte, payload []byte) []byte {
+	buf = append(buf, op)
+	buf = binary.AppendUvarint(buf, uint64(len(payload)))
+	buf = append(buf, payload...)
+
+	sum := crc32.NewIEEE()
+	sum.Write([]byte{op})
+	sum.Write(payload)
+
+	return binary.BigEndian.AppendUint32(buf, sum.Sum32())
+}
+
+/*
+logBatchPayload returns the payload of the batch entry bearing muts.
+*/
+func logBatchPayload(muts []Mutation) (payload []byte) {
+	for i := 0; i < len(muts); i++ {
+		op, rec := logRegister, muts[i].Record
+		if muts[i].Op == MutationUnregister {
+			op, rec = logUnregister, Record{Dot: rec.Dot}
+		}
+		sub := appendRecord(nil, nil, rec)
+		payload = append(payload, op)
+		payload = binary.AppendUvarint(payload, uint64(len(sub)))
+		payload = append(payload, sub...)
+	}
+
+	return
+}
+
+/*
+replayRegistryLog applies each entry of the registry log f to reg, leaving
+f positioned at the end of its last complete entry. An empty f is
+initialized with a header. The replay ceases with the error of ctx once
+ctx is done.
+*/
+func replayRegistryLog(ctx context.Context, f *os.File, reg *Registry) (err error) {
+	br := bufio.NewReader(f)
+	hdr := make([]byte, len(registryLogMagic)+1)
+	if _, err = io.ReadFull(br, hdr); err == io.EOF {
+		// New (empty) log.
+		if _, err = f.Write(append([]byte(registryLogMagic), registryLogVersion)); err == nil {
+			err = f.Sync()
+		}
+		return
+	} else if err != nil || string(hdr[:len(registryLogMagic)]) != registryLogMagic {
+		err = errorf("Invalid registry log magic")
+		return
+	} else if hdr[len(registryLogMagic)] != registryLogVersion {
+		err = errorf("Unsupported registry log version %d", hdr[len(registryLogMagic)])
+		return
+	}
+
+	offset := int64(len(hdr))
+	for n := 0; ; n++ {
+		var (
+			op   byte
+			size int64
+			muts []Mutation
+		)
+		if err = canceled(ctx); err != nil {
+			return
+		} else if op, size, muts, err = readLogEntry(br); err == io.EOF {
+			err = nil
+			break
+		} else if errors.Is(err, io.ErrUnexpectedEOF) {
+			// Torn final entry; discard it.
+			if err = f.Truncate(offset); err != nil {
+				return
+			}
+			break
+		} else if err != nil {
+			err = errorf("Registry log entry %d: %v", n, err)
+			return
+		}
+
+		switch op {
+		case logRegister:
+			err = reg.Register(muts[0].Record)
+		case logUnregister:
+			reg.Unregister(muts[0].Record.Dot)
+		case logBatch:
+			err = reg.Apply(muts)
+		default:
+			err = errorf("Registry log entry %d: unknown operation %d", n, op)
+		}
+
+		if err != nil {
+			return
+		}
+		offset += size
+	}
+
+	_, err = f.Seek(offset, io.SeekStart)
+
+	return
+}
+
+/*
+readLogEntry reads a single registry log entry from br, returning its
+operation, total size in octets, decoded [Mutation] instances and an
+error. A register or unregister entry yields a single [Mutation]. io.EOF
+is returned only if br ends cleanly before the entry, and an error
+wrapping io.ErrUnexpectedEOF is returned if the entry is incomplete.
+*/
+func readLogEntry(br *bufio.Reader) (op byte, size int64, muts []Mutation, err error) {
+	if op, err = br.ReadByte(); err != nil {
+		return
+	}
+
+	var length uint64
+	if length, err = binary.ReadUvarint(br); err == io.EOF {
+		err = io.ErrUnexpectedEOF
+		return
+	} else if err != nil {
+		return
+	} else if length > logEntryLimit(op) {
+		err = errorf("Entry length %d exceeds maximum", length)
+		return
+	}
+
+	body := make([]byte, length+4)
+	if _, err = io.ReadFull(br, body); err != nil {
+		err = io.ErrUnexpectedEOF
+		return
+	}
+
+	payload := body[:length]
+	sum := crc32.NewIEEE()
+	sum.Write([]byte{op})
+	sum.Write(payload)
+	if sum.Sum32() != binary.BigEndian.Uint32(body[length:]) {
+		err = errorf("Checksum mismatch")
+		return
+	}
+
+	if op == logBatch {
+		muts, err = readLogBatch(payload)
+	} else {
+		var rec Record
+		if rec, err = readRecord(bufio.NewReader(bytes.NewReader(payload)), nil); err == nil {
+			muts = []Mutation{{Op: MutationRegister, Record: rec}}
+			if op == logUnregister {
+				muts[0].Op = MutationUnregister
+			}
+		}
+	}
+
+	if err == nil {
+		size = int64(1+len(binary.AppendUvarint(nil, length))) + int64(len(body))
+	}
+
+	return
+}
+
+/*
+readLogBatch decodes the payload of a batch entry, returning the
+[Mutation] instances it bears alongside an error.
+*/
+func readLogBatch(payload []byte) (muts []Mutation, err error) {
+	br := bufio.NewReader(bytes.NewReader(payload))
+	for {
+		var (
+			op     byte
+			length uint64
+			rec    Record
+		)
+		if op, err = br.ReadByte(); err == io.EOF {
+			err = nil
+			break
+		} else if length, err = binary.ReadUvarint(br); err != nil {
+			err = errorf("Truncated batch operation %d", len(muts))
+			break
+		} else if op != logRegister && op != logUnregister {
+			err = errorf("Unknown batch operation %d", op)
+			break
+		}
+
+		if length > maxLogEntryLength {
+			err = errorf("Batch operation length %d exceeds maximum", length)
+			break
+		}
+
+		sub := make([]byte, length)
+		if _, err = io.ReadFull(br, sub); err != nil {
+			err = errorf("Truncated batch operation %d", len(muts))
+		} else if rec, err = readRecord(bufio.NewReader(bytes.NewReader(sub)), nil); err == nil {
+			mut := Mutation{Op: MutationRegister, Record: rec}
+			if op == logUnregister {
+				mut.Op = MutationUnregister
+			}
+			muts = append(muts, mut)
+		}
+
+		if err != nil {
+			break
+		}
+	}
+
+	return
+}
+
+/*
+logEntryLimit returns the maximum payload length of a log entry bearing
+the operation op.
+*/
+func logEntryLimit(op byte) uint64 {
+	if op == logBatch {
+		return maxLogBatchLength
+	}
+
+	return maxLogEntryLength
+}
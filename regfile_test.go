@@ -0,0 +1,178 @@
+package objectid
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRegistryFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), `registry.log`)
+
+	rf, err := OpenRegistryFile(path)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	for _, rec := range []Record{
+		{Dot: mustDot(`1.3.6.1.4.1.56521`), Identifier: `example`},
+		{Dot: mustDot(`1.3.6.1.4.1.56521.999`), Description: `Test arc`},
+		{Dot: mustDot(`2.25.987895962269883002155146617097157934`)},
+		{Dot: mustDot(`1.3.6.1.4.1.56521`), Identifier: `replaced`},
+	} {
+		if err = rf.Register(rec); err != nil {
+			t.Fatalf("%s failed: %v", t.Name(), err)
+		}
+	}
+
+	if removed, err := rf.Unregister(`2.25.987895962269883002155146617097157934`); !removed || err != nil {
+		t.Fatalf("%s failed: unregister failed (%v)", t.Name(), err)
+	} else if removed, _ = rf.Unregister(`2.999`); removed {
+		t.Fatalf("%s failed: bogus unregister succeeded", t.Name())
+	} else if err = rf.Register(Record{}); err == nil {
+		t.Fatalf("%s failed: invalid record registered", t.Name())
+	} else if err = rf.Close(); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if err = rf.Register(Record{Dot: mustDot(`2.999`)}); err == nil {
+		t.Fatalf("%s failed: register succeeded after close", t.Name())
+	}
+
+	verify := func(rf *RegistryFile) {
+		t.Helper()
+		reg := rf.Registry()
+		if reg.Len() != 2 {
+			t.Fatalf("%s failed: want 2 records, got %d", t.Name(), reg.Len())
+		} else if rec, _ := reg.Lookup(`1.3.6.1.4.1.56521`); rec.Identifier != `replaced` {
+			t.Errorf("%s failed: bad identifier '%s'", t.Name(), rec.Identifier)
+		} else if rec, _ = reg.Lookup(`1.3.6.1.4.1.56521.999`); rec.Description != `Test arc` {
+			t.Errorf("%s failed: bad description '%s'", t.Name(), rec.Description)
+		}
+	}
+
+	if rf, err = OpenRegistryFile(path); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+	verify(rf)
+
+	before, _ := os.Stat(path)
+	if err = rf.Compact(); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if after, _ := os.Stat(path); after.Size() >= before.Size() {
+		t.Errorf("%s failed: compaction did not shrink log (%d >= %d)", t.Name(), after.Size(), before.Size())
+	}
+
+	// Writes following compaction must land in the new log.
+	if err = rf.Register(Record{Dot: mustDot(`2.999`)}); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if _, err = rf.Unregister(`2.999`); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+	rf.Close()
+
+	if rf, err = OpenRegistryFile(path); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+	verify(rf)
+	rf.Close()
+}
+
+func TestRegistryFile_recovery(t *testing.T) {
+	path := filepath.Join(t.TempDir(), `registry.log`)
+
+	rf, _ := OpenRegistryFile(path)
+	_ = rf.Register(Record{Dot: mustDot(`2.999.1`)})
+	_ = rf.Register(Record{Dot: mustDot(`2.999.2`)})
+	rf.Close()
+
+	// Simulate a torn write of the final entry.
+	info, _ := os.Stat(path)
+	if err := os.Truncate(path, info.Size()-2); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	rf, err := OpenRegistryFile(path)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if rf.Registry().Len() != 1 {
+		t.Fatalf("%s failed: want 1 surviving record, got %d", t.Name(), rf.Registry().Len())
+	}
+
+	_ = rf.Register(Record{Dot: mustDot(`2.999.3`)})
+	rf.Close()
+
+	if rf, err = OpenRegistryFile(path); err != nil || rf.Registry().Len() != 2 {
+		t.Fatalf("%s failed: log not repaired (%v)", t.Name(), err)
+	}
+	rf.Close()
+
+	// Corruption within an entry is reported, not discarded.
+	b, _ := os.ReadFile(path)
+	b[len(registryLogMagic)+3] ^= 0xff
+	_ = os.WriteFile(path, b, 0o644)
+	if _, err = OpenRegistryFile(path); err == nil {
+		t.Errorf("%s failed: no error for corrupt log", t.Name())
+	}
+
+	_ = os.WriteFile(path, []byte(`bogus`), 0o644)
+	if _, err = OpenRegistryFile(path); err == nil {
+		t.Errorf("%s failed: no error for bogus log", t.Name())
+	}
+}
+
+func TestRegistryFile_writeError(t *testing.T) {
+	rf, err := OpenRegistryFile(filepath.Join(t.TempDir(), `registry.log`))
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if err = rf.Register(Record{Dot: mustDot(`2.999.1`)}); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	// A failed log write must leave the registry untouched.
+	rf.f.Close()
+	if err = rf.Register(Record{Dot: mustDot(`2.999.2`)}); err == nil {
+		t.Errorf("%s failed: expected write error for register", t.Name())
+	} else if _, found := rf.Registry().Lookup(`2.999.2`); found {
+		t.Errorf("%s failed: record registered despite write error", t.Name())
+	}
+
+	if removed, err := rf.Unregister(`2.999.1`); err == nil || removed {
+		t.Errorf("%s failed: expected write error for unregister", t.Name())
+	} else if _, found := rf.Registry().Lookup(`2.999.1`); !found {
+		t.Errorf("%s failed: record removed despite write error", t.Name())
+	}
+}
+
+func TestRegistryFile_context(t *testing.T) {
+	path := filepath.Join(t.TempDir(), `registry.log`)
+	rf, err := OpenRegistryFile(path)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+	if err = rf.Register(Record{Dot: mustDot(`1.3.6.1`), Identifier: `internet`}); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err = rf.CompactContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("%s failed: want context.Canceled, got %v", t.Name(), err)
+	} else if _, err = os.Stat(path + `.compact`); !os.IsNotExist(err) {
+		t.Errorf("%s failed: temporary file not removed: %v", t.Name(), err)
+	} else if err = rf.Close(); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	if _, err = OpenRegistryFileContext(ctx, path); !errors.Is(err, context.Canceled) {
+		t.Errorf("%s failed: want context.Canceled, got %v", t.Name(), err)
+	} else if rf, err = OpenRegistryFileContext(context.Background(), path); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+	defer rf.Close()
+
+	if rf.Registry().Len() != 1 {
+		t.Errorf("%s failed: want 1 record, got %d", t.Name(), rf.Registry().Len())
+	}
+}
@@ -0,0 +1,261 @@
+package objectid
+
+/*
+registry.go implements the Registry type, a user-populated store of
+OID registration records.
+*/
+
+import (
+	"regexp"
+	"sync"
+)
+
+/*
+Record contains a single registration within a [Registry].
+*/
+type Record struct {
+	// Dot contains the numeric form of the registration (required).
+	Dot DotNotation
+
+	// Identifier contains the ASN.1 identifier (nameForm) of the
+	// final arc, if any (e.g.: "enterprise").
+	Identifier string
+
+	// Description contains a free-form description of the registration.
+	Description string
+
+	// Authority contains the metadata of the registration authority
+	// responsible for the arc, if any. See [Registry.Authority].
+	Authority *RegistrationAuthority
+}
+
+/*
+Registry is a store of [Record] instances, each keyed by its [DotNotation].
+
+Records are held within a path-compressed tree, such that arcs shared by
+many registrations (e.g.: "1.3.6.1.4.1") are stored but once, and small
+arcs share interned storage. See [Registry.Stats] for details.
+
+Instances of this type are safe for concurrent use, and should be created
+using the [NewRegistry] function.
+*/
+type Registry struct {
+	mu          sync.RWMutex
+	root        regNode
+	count       int
+	constraints map[string]Constraint
+}
+
+/*
+NewRegistry returns a freshly initialized instance of *[Registry].
+*/
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+/*
+Len returns the integer number of records present within the receiver.
+*/
+func (r *Registry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.count
+}
+
+/*
+Stats returns an instance of [RegistryStats] describing the internal
+storage of the receiver, allowing the effect of path compression and
+arc interning to be verified.
+*/
+func (r *Registry) Stats() (st RegistryStats) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	r.root.stats(0, &st)
+
+	return
+}
+
+/*
+Register adds rec to the receiver, replacing any record previously held
+for the same [DotNotation]. An error is returned if rec's [DotNotation]
+is zero or has a root arc greater than two (2), if a non-zero Identifier
+does not satisfy [IsIdentifier], if a non-nil Authority bears no name, an
+invalid status or inconsistent times, or if a new record would violate the
+[Constraint] attached to its parent arc (see [Registry.SetConstraint]).
+*/
+func (r *Registry) Register(rec Record) (err error) {
+	if err = rec.validate(); err != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err = r.checkChild(rec.Dot); err == nil {
+		r.store(rec)
+	}
+
+	return
+}
+
+/*
+store adds rec to the receiver. The caller must hold the write lock.
+*/
+func (r *Registry) store(rec Record) {
+	entry := &regEntry{
+		identifier:  rec.Identifier,
+		description: rec.Description,
+		authority:   rec.Authority.clone(),
+	}
+	if r.root.insert(rec.Dot, entry) {
+		r.count++
+	}
+}
+
+/*
+Lookup returns the [Record] registered for dot, which can be a string or
+[DotNotation], alongside a Boolean value indicative of a successful lookup.
+*/
+func (r *Registry) Lookup(dot any) (rec Record, found bool) {
+	if d, ok := registryDot(dot); ok {
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		if node := r.root.find(d); node != nil {
+			rec, found = node.entry.record(d), true
+		}
+	}
+
+	return
+}
+
+/*
+Unregister removes the [Record] registered for dot, which can be a string
+or [DotNotation], returning a Boolean value indicative of whether a record
+was removed.
+*/
+func (r *Registry) Unregister(dot any) (removed bool) {
+	if d, ok := registryDot(dot); ok {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if removed = r.root.remove(d); removed {
+			r.count--
+		}
+	}
+
+	return
+}
+
+/*
+Records returns all [Record] instances within the receiver, ordered by
+their respective [DotNotation] values such that each ancestor precedes
+its descendants.
+*/
+func (r *Registry) Records() (recs []Record) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	recs = make([]Record, 0, r.count)
+	r.root.walk(nil, func(dot DotNotation, entry *regEntry) {
+		recs = append(recs, entry.record(dot))
+	})
+
+	return
+}
+
+/*
+Search returns all [Record] instances within the receiver whose Identifier
+or Description matches at least one of the input patterns, ordered in the
+manner described by the [Registry.Records] method. Valid pattern types are:
+
+  - string, matched as a case-insensitive substring (e.g.: "example")
+  - *[regexp.Regexp], matched as-is
+
+An error is returned if an unsupported or nil pattern is encountered.
+*/
+func (r *Registry) Search(patterns ...any) (recs []Record, err error) {
+	var matchers []func(string) bool
+	for i := 0; i < len(patterns); i++ {
+		switch tv := patterns[i].(type) {
+		case string:
+			sub := lc(tv)
+			matchers = append(matchers, func(val string) bool {
+				return contains(lc(val), sub)
+			})
+		case *regexp.Regexp:
+			if tv == nil {
+				err = errorf("Nil %T search pattern", tv)
+				return
+			}
+			matchers = append(matchers, tv.MatchString)
+		default:
+			err = errorf("Unsupported %T search pattern: %#v", tv, tv)
+			return
+		}
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	r.root.walk(nil, func(dot DotNotation, entry *regEntry) {
+		for i := 0; i < len(matchers); i++ {
+			if matchers[i](entry.identifier) || matchers[i](entry.description) {
+				recs = append(recs, entry.record(dot))
+				break
+			}
+		}
+	})
+
+	return
+}
+
+/*
+validate returns an error if the receiver is unsuitable for registration.
+*/
+func (r Record) validate() (err error) {
+	if r.Dot.Len() == 0 {
+		err = errorf("%T bears a zero %T", r, r.Dot)
+	} else if !r.Dot.Root().Lt(3) || r.Dot[0].cast().Sign() < 0 {
+		err = errorf("%T bears an invalid root arc (%s)", r, r.Dot.Root())
+	} else if len(r.Identifier) > 0 && !isIdentifier(r.Identifier) {
+		err = errorf("%T bears an invalid identifier '%s'", r, r.Identifier)
+	} else if r.Authority != nil {
+		err = r.Authority.validate()
+	}
+
+	return
+}
+
+/*
+compare returns an integer comparing the receiver to dot arc-by-arc. The
+result is -1 if the receiver sorts first, 1 if dot sorts first, and zero
+(0) if they are equal. An ancestor always sorts before its descendants.
+*/
+func (r DotNotation) compare(dot DotNotation) int {
+	for i := 0; i < r.Len() && i < dot.Len(); i++ {
+		if c := r[i].cast().Cmp(dot[i].cast()); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case r.Len() < dot.Len():
+		return -1
+	case r.Len() > dot.Len():
+		return 1
+	}
+
+	return 0
+}
+
+/*
+clone returns an independent copy of the receiver.
+*/
+func (r DotNotation) clone() (d DotNotation) {
+	if r != nil {
+		d = make(DotNotation, r.Len())
+		copy(d, r)
+	}
+
+	return
+}
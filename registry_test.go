@@ -0,0 +1,178 @@
+package objectid
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+func ExampleRegistry_Register() {
+	reg := NewRegistry()
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	if err := reg.Register(Record{Dot: *dot, Identifier: `example`}); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	rec, _ := reg.Lookup(`1.3.6.1.4.1.56521`)
+	fmt.Println(rec.Identifier)
+	// Output: example
+}
+
+func newTestRegistry(t *testing.T) *Registry {
+	reg := NewRegistry()
+	for dot, id := range map[string]string{
+		`1.3.6.1.4.1`:           `enterprise`,
+		`1.3.6.1.4.1.56521`:     ``,
+		`1.3.6.1.4.1.56521.999`: `example`,
+		`1.3.6.1.4.1.56521.2`:   `schema`,
+		`2.25`:                  `uuid`,
+		`1.3`:                   `identified-organization`,
+	} {
+		d, err := NewDotNotation(dot)
+		if err != nil {
+			t.Fatalf("%s failed: %v", t.Name(), err)
+		}
+		if err = reg.Register(Record{Dot: *d, Identifier: id, Description: `test ` + dot}); err != nil {
+			t.Fatalf("%s failed: %v", t.Name(), err)
+		}
+	}
+
+	return reg
+}
+
+func TestRegistry(t *testing.T) {
+	reg := newTestRegistry(t)
+	if reg.Len() != 6 {
+		t.Errorf("%s failed: want 6 records, got %d", t.Name(), reg.Len())
+	}
+
+	want := []string{`1.3`, `1.3.6.1.4.1`, `1.3.6.1.4.1.56521`,
+		`1.3.6.1.4.1.56521.2`, `1.3.6.1.4.1.56521.999`, `2.25`}
+	recs := reg.Records()
+	for i := 0; i < len(recs); i++ {
+		if got := recs[i].Dot.String(); got != want[i] {
+			t.Errorf("%s failed: want '%s' at %d, got '%s'", t.Name(), want[i], i, got)
+		}
+	}
+
+	if !reg.Unregister(`2.25`) || reg.Unregister(`2.25`) {
+		t.Errorf("%s failed: bogus unregister result", t.Name())
+	} else if _, found := reg.Lookup(`2.25`); found {
+		t.Errorf("%s failed: unregistered record still present", t.Name())
+	}
+
+	for idx, bogus := range []Record{
+		{},
+		{Dot: DotNotation{NumberForm(*NumberForm{}.cast().SetInt64(3))}},
+		{Dot: DotNotation{ISO.primaryIdentifier}, Identifier: `Bogus`},
+	} {
+		if err := reg.Register(bogus); err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nothing", t.Name(), idx)
+		}
+	}
+}
+
+func ExampleRegistry_Search() {
+	reg := NewRegistry()
+	for dot, id := range map[string]string{
+		`2.999`:   `example`,
+		`2.999.1`: `counterexample`,
+		`2.999.2`: `widget`,
+	} {
+		d, _ := NewDotNotation(dot)
+		reg.Register(Record{Dot: *d, Identifier: id})
+	}
+
+	recs, err := reg.Search(`example`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for i := 0; i < len(recs); i++ {
+		fmt.Println(recs[i].Dot)
+	}
+	// Output:
+	// 2.999
+	// 2.999.1
+}
+
+func TestRegistry_Search(t *testing.T) {
+	reg := newTestRegistry(t)
+
+	for _, tc := range []struct {
+		patterns []any
+		want     []string
+	}{
+		{[]any{`EXAMPLE`}, []string{`1.3.6.1.4.1.56521.999`}},
+		{[]any{`56521`}, []string{`1.3.6.1.4.1.56521`, `1.3.6.1.4.1.56521.2`, `1.3.6.1.4.1.56521.999`}},
+		{[]any{regexp.MustCompile(`^(uuid|schema)$`)}, []string{`1.3.6.1.4.1.56521.2`, `2.25`}},
+		{[]any{`enterprise`, regexp.MustCompile(`^identified-`)}, []string{`1.3`, `1.3.6.1.4.1`}},
+		{[]any{`nonexistent`}, nil},
+		{nil, nil},
+	} {
+		recs, err := reg.Search(tc.patterns...)
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			continue
+		} else if len(recs) != len(tc.want) {
+			t.Errorf("%s failed: want %d records, got %d", t.Name(), len(tc.want), len(recs))
+			continue
+		}
+		for i := 0; i < len(recs); i++ {
+			if got := recs[i].Dot.String(); got != tc.want[i] {
+				t.Errorf("%s failed: want '%s' at %d, got '%s'", t.Name(), tc.want[i], i, got)
+			}
+		}
+	}
+
+	var re *regexp.Regexp
+	for _, bogus := range []any{3, re} {
+		if _, err := reg.Search(bogus); err == nil {
+			t.Errorf("%s failed: bogus pattern %T accepted", t.Name(), bogus)
+		}
+	}
+}
+
+func TestRegistry_Stats(t *testing.T) {
+	reg := NewRegistry()
+	for i := 0; i < 100; i++ {
+		dot, _ := NewDotNotation(sprintf("1.3.6.1.4.1.%d", 50000+i))
+		if err := reg.Register(Record{Dot: *dot}); err != nil {
+			t.Fatalf("%s failed: %v", t.Name(), err)
+		}
+	}
+
+	st := reg.Stats()
+	if st.Records != 100 || st.FlatArcs != 700 {
+		t.Errorf("%s failed: bad totals %#v", t.Name(), st)
+	} else if st.StoredArcs != 106 || st.Nodes != 101 || st.InternedArcs != 6 {
+		t.Errorf("%s failed: prefix not shared %#v", t.Name(), st)
+	}
+
+	// Registering and removing a record at the
+	// branch point must leave storage unchanged.
+	_ = reg.Register(Record{Dot: mustDot(`1.3.6.1`)})
+	if !reg.Unregister(`1.3.6.1`) || reg.Stats() != st {
+		t.Errorf("%s failed: storage not recompressed %#v", t.Name(), reg.Stats())
+	}
+
+	for i := 0; i < 99; i++ {
+		reg.Unregister(sprintf("1.3.6.1.4.1.%d", 50000+i))
+	}
+
+	if st = reg.Stats(); st.Records != 1 || st.Nodes != 1 || st.StoredArcs != 7 {
+		t.Errorf("%s failed: storage not pruned %#v", t.Name(), st)
+	} else if rec, found := reg.Lookup(`1.3.6.1.4.1.50099`); !found || rec.Dot.String() != `1.3.6.1.4.1.50099` {
+		t.Errorf("%s failed: surviving record lost", t.Name())
+	}
+}
+
+func mustDot(raw string) DotNotation {
+	dot, err := NewDotNotation(raw)
+	if err != nil {
+		panic(err)
+	}
+	return *dot
+}
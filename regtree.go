@@ -0,0 +1,357 @@
+package objectid
+
+/*
+regtree.go implements the prefix-compressed storage underlying the
+Registry type.
+*/
+
+import "sort"
+
+/*
+regEntry contains the non-numeric content of a single [Record].
+*/
+type regEntry struct {
+	identifier  string
+	description string
+	authority   *RegistrationAuthority
+}
+
+/*
+record returns an instance of [Record] bearing the contents of the
+receiver and an independent copy of dot.
+*/
+func (r *regEntry) record(dot DotNotation) Record {
+	return Record{
+		Dot:         dot.clone(),
+		Identifier:  r.identifier,
+		Description: r.description,
+		Authority:   r.authority.clone(),
+	}
+}
+
+/*
+registryDot returns the [DotNotation] identified by dot, which can be a
+string or [DotNotation], alongside a Boolean value indicative of success.
+A root arc alone (e.g.: "1") is accepted, as with [RegisterIdentifier].
+*/
+func registryDot(dot any) (d DotNotation, ok bool) {
+	switch tv := dot.(type) {
+	case string:
+		d, ok = parseArcKey(tv)
+	case *DotNotation:
+		if tv != nil {
+			d, ok = registryDot(*tv)
+		}
+	case DotNotation:
+		if ok = tv.Len() > 0 && tv.Root().Lt(3); ok {
+			d = tv
+		}
+	}
+
+	return
+}
+
+/*
+regNode is a single node within the path-compressed tree underlying a
+[Registry]. The label of each node contains one (1) or more arcs, such
+that chains of nodes bearing neither records nor siblings are collapsed
+into a single node. Child nodes are ordered by the first arc of their
+respective labels.
+*/
+type regNode struct {
+	label []NumberForm
+	entry *regEntry
+	kids  []*regNode
+}
+
+/*
+RegistryStats contains memory statistics describing the internal storage
+of a [Registry], as returned by the [Registry.Stats] method.
+*/
+type RegistryStats struct {
+	// Records contains the number of records held.
+	Records int
+
+	// Nodes contains the number of internal tree nodes, which may be
+	// fewer than the number of records thanks to path compression.
+	Nodes int
+
+	// StoredArcs contains the number of arcs actually stored across
+	// all node labels.
+	StoredArcs int
+
+	// InternedArcs contains the number of stored arcs which share the
+	// backing of an interned instance.
+	InternedArcs int
+
+	// FlatArcs contains the number of arcs that would be stored were
+	// each record to bear its complete [DotNotation], for comparison
+	// with StoredArcs.
+	FlatArcs int
+}
+
+/*
+childIndex returns the index of the child of the receiver whose label
+begins with arc, alongside a Boolean value indicative of a match. If no
+match is found, the index at which such a child would be inserted is
+returned.
+*/
+func (r *regNode) childIndex(arc NumberForm) (idx int, found bool) {
+	a := arc.cast()
+	idx = sort.Search(len(r.kids), func(i int) bool {
+		return r.kids[i].label[0].cast().Cmp(a) >= 0
+	})
+	found = idx < len(r.kids) && r.kids[idx].label[0].cast().Cmp(a) == 0
+
+	return
+}
+
+/*
+insert stores entry at dot beneath the receiver, returning a Boolean
+value indicative of whether a new record was created (as opposed to an
+existing record being replaced).
+*/
+func (r *regNode) insert(dot DotNotation, entry *regEntry) (added bool) {
+	node := r
+	for dot.Len() > 0 {
+		idx, found := node.childIndex(dot[0])
+		if !found {
+			kid := &regNode{label: internLabel(dot), entry: entry}
+			node.kids = append(node.kids, nil)
+			copy(node.kids[idx+1:], node.kids[idx:])
+			node.kids[idx] = kid
+			return true
+		}
+
+		kid := node.kids[idx]
+		k := sharedArcs(kid.label, dot)
+		if k < len(kid.label) {
+			// Split the label of kid, inserting an
+			// intermediate node at the divergence.
+			mid := &regNode{
+				label: kid.label[:k:k],
+				kids:  []*regNode{kid},
+			}
+			kid.label = kid.label[k:]
+			node.kids[idx] = mid
+			kid = mid
+		}
+
+		node, dot = kid, dot[k:]
+	}
+
+	added = node.entry == nil
+	node.entry = entry
+
+	return
+}
+
+/*
+find returns the node bearing the record registered at dot beneath the
+receiver, or nil if no such record exists.
+*/
+func (r *regNode) find(dot DotNotation) *regNode {
+	node := r
+	for dot.Len() > 0 {
+		idx, found := node.childIndex(dot[0])
+		if !found {
+			return nil
+		}
+
+		kid := node.kids[idx]
+		if k := sharedArcs(kid.label, dot); k < len(kid.label) {
+			return nil
+		}
+		node, dot = kid, dot[len(kid.label):]
+	}
+
+	if node.entry == nil {
+		return nil
+	}
+
+	return node
+}
+
+/*
+children returns the number of records registered immediately beneath
+prefix, which is relative to the receiver.
+*/
+func (r *regNode) children(prefix DotNotation) (n int) {
+	node := r
+	for prefix.Len() > 0 {
+		idx, found := node.childIndex(prefix[0])
+		if !found {
+			return
+		}
+
+		kid := node.kids[idx]
+		k := sharedArcs(kid.label, prefix)
+		if k < prefix.Len() && k < len(kid.label) {
+			return // diverges
+		} else if k < len(kid.label) {
+			// Prefix ends within the label of kid, so
+			// kid bears the only possible child.
+			if len(kid.label)-k == 1 && kid.entry != nil {
+				n = 1
+			}
+			return
+		}
+		node, prefix = kid, prefix[k:]
+	}
+
+	for i := 0; i < len(node.kids); i++ {
+		if len(node.kids[i].label) == 1 && node.kids[i].entry != nil {
+			n++
+		}
+	}
+
+	return
+}
+
+/*
+remove deletes the record registered at dot beneath the receiver,
+returning a Boolean value indicative of success. Nodes left without
+purpose are pruned, and chains are re-compressed.
+*/
+func (r *regNode) remove(dot DotNotation) (removed bool) {
+	if dot.Len() == 0 {
+		return
+	}
+
+	idx, found := r.childIndex(dot[0])
+	if !found {
+		return
+	}
+
+	kid := r.kids[idx]
+	if k := sharedArcs(kid.label, dot); k < len(kid.label) {
+		return
+	} else if k == dot.Len() {
+		if removed = kid.entry != nil; removed {
+			kid.entry = nil
+		}
+	} else {
+		removed = kid.remove(dot[k:])
+	}
+
+	if removed {
+		switch {
+		case kid.entry == nil && len(kid.kids) == 0:
+			r.kids = append(r.kids[:idx], r.kids[idx+1:]...)
+		case kid.entry == nil && len(kid.kids) == 1:
+			only := kid.kids[0]
+			label := make([]NumberForm, 0, len(kid.label)+len(only.label))
+			only.label = append(append(label, kid.label...), only.label...)
+			r.kids[idx] = only
+		}
+	}
+
+	return
+}
+
+/*
+walk calls fn with the complete [DotNotation] and entry of each record
+beneath the receiver, such that each ancestor precedes its descendants
+and siblings are visited in ascending numerical order. The path passed
+to fn is only valid for the duration of the call.
+*/
+func (r *regNode) walk(path DotNotation, fn func(DotNotation, *regEntry)) {
+	path = append(path, r.label...)
+	if r.entry != nil {
+		fn(path, r.entry)
+	}
+
+	for i := 0; i < len(r.kids); i++ {
+		r.kids[i].walk(path, fn)
+	}
+}
+
+/*
+visit is the same as walk, except that the walk ceases once fn returns
+false, in which case false is returned.
+*/
+func (r *regNode) visit(path DotNotation, fn func(DotNotation, *regEntry) bool) bool {
+	path = append(path, r.label...)
+	if r.entry != nil && !fn(path, r.entry) {
+		return false
+	}
+
+	for i := 0; i < len(r.kids); i++ {
+		if !r.kids[i].visit(path, fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+subtree returns the node beneath the receiver whose complete path bears
+prefix, alongside the path of its parent, or nil if no such node exists.
+As labels may span several arcs, the complete path of the node returned
+may be longer than prefix.
+*/
+func (r *regNode) subtree(prefix DotNotation) (node *regNode, path DotNotation) {
+	node = r
+	for prefix.Len() > 0 {
+		idx, found := node.childIndex(prefix[0])
+		if !found {
+			return nil, nil
+		}
+
+		kid := node.kids[idx]
+		k := sharedArcs(kid.label, prefix)
+		if k < prefix.Len() && k < len(kid.label) {
+			return nil, nil // diverges
+		} else if k < len(kid.label) {
+			return kid, path // prefix ends within label
+		}
+		path = append(path, kid.label...)
+		node, prefix = kid, prefix[k:]
+	}
+
+	if node != r {
+		// Exclude the label of node, which
+		// visit will append on its own.
+		path = path[:len(path)-len(node.label)]
+	}
+
+	return
+}
+
+/*
+stats accumulates the memory statistics of the receiver and its
+descendants within st, given the depth of the receiver.
+*/
+func (r *regNode) stats(depth int, st *RegistryStats) {
+	depth += len(r.label)
+	st.StoredArcs += len(r.label)
+	for i := 0; i < len(r.label); i++ {
+		if _, ok := internArc(r.label[i]); ok {
+			st.InternedArcs++
+		}
+	}
+
+	if r.entry != nil {
+		st.Records++
+		st.FlatArcs += depth
+	}
+
+	for i := 0; i < len(r.kids); i++ {
+		st.Nodes++
+		r.kids[i].stats(depth, st)
+	}
+}
+
+/*
+internLabel returns a copy of dot suitable for use as a node label, in
+which small arcs are replaced by their interned instances.
+*/
+func internLabel(dot DotNotation) (label []NumberForm) {
+	label = make([]NumberForm, dot.Len())
+	for i := 0; i < dot.Len(); i++ {
+		label[i], _ = internArc(dot[i])
+	}
+
+	return
+}
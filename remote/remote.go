@@ -0,0 +1,197 @@
+/*
+Package remote implements a reference HTTP client satisfying the
+[objectid.RemoteResolver] interface, allowing unknown OIDs to be described
+on demand by a web service.
+*/
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/oid-directory/go-objectid"
+)
+
+/*
+Endpoint pattern placeholders, each of which is replaced with a rendering
+of the requested [objectid.DotNotation] by [HTTPResolver.Resolve].
+*/
+const (
+	// PlaceholderDot is replaced with the dot notation of the OID
+	// (e.g.: "1.3.6.1.4.1.56521").
+	PlaceholderDot = `{oid}`
+
+	// PlaceholderPath is replaced with the arcs of the OID delimited
+	// by solidi (e.g.: "1/3/6/1/4/1/56521").
+	PlaceholderPath = `{path}`
+)
+
+/*
+MaxResponseSize is the maximum number of octets read from a response body.
+*/
+const MaxResponseSize = 1 << 20
+
+/*
+HTTPResolver is an [objectid.RemoteResolver] which obtains records using
+HTTP GET requests against a configurable endpoint, such as a service in the
+manner of oid-info.com.
+
+By default, responses are expected to bear a JSON object of the form:
+
+	{"oid": "1.3.6.1.4.1.56521", "identifier": "example", "description": "..."}
+
+The "oid" member is optional, and must match the requested OID if present.
+A 404 (Not Found) response results in an error wrapping [objectid.ErrNotFound].
+
+Instances of this type are safe for concurrent use, provided their fields
+are not modified after first use.
+*/
+type HTTPResolver struct {
+	// Endpoint contains the URL pattern to request, bearing at least
+	// one placeholder (e.g.: "https://example.com/oid/{oid}.json").
+	Endpoint string
+
+	// Client contains the *http.Client used to perform requests. If
+	// nil, http.DefaultClient is used.
+	Client *http.Client
+
+	// Header contains additional headers to send with each request,
+	// such as authorization credentials.
+	Header http.Header
+
+	// Decode, if non-nil, replaces the default JSON response decoding.
+	// It is called with the requested OID and the response body, which
+	// is limited to MaxResponseSize octets.
+	Decode func(dot objectid.DotNotation, body io.Reader) (objectid.Record, error)
+}
+
+/*
+NewHTTPResolver returns an instance of *[HTTPResolver] bearing endpoint
+alongside an error, which is returned if endpoint lacks a placeholder.
+*/
+func NewHTTPResolver(endpoint string) (r *HTTPResolver, err error) {
+	if !strings.Contains(endpoint, PlaceholderDot) && !strings.Contains(endpoint, PlaceholderPath) {
+		err = fmt.Errorf("Endpoint '%s' bears no %s or %s placeholder", endpoint, PlaceholderDot, PlaceholderPath)
+		return
+	}
+
+	r = &HTTPResolver{Endpoint: endpoint}
+
+	return
+}
+
+/*
+Resolve returns the [objectid.Record] describing dot, as obtained from the
+endpoint of the receiver, alongside an error. This satisfies the
+[objectid.RemoteResolver] interface.
+*/
+func (r *HTTPResolver) Resolve(ctx context.Context, dot objectid.DotNotation) (rec objectid.Record, err error) {
+	if dot.Len() == 0 {
+		err = fmt.Errorf("Cannot resolve zero %T", dot)
+		return
+	}
+
+	var req *http.Request
+	if req, err = http.NewRequestWithContext(ctx, http.MethodGet, r.url(dot), nil); err != nil {
+		return
+	}
+	for key, vals := range r.Header {
+		for i := 0; i < len(vals); i++ {
+			req.Header.Add(key, vals[i])
+		}
+	}
+	if req.Header.Get(`Accept`) == `` && r.Decode == nil {
+		req.Header.Set(`Accept`, `application/json`)
+	}
+
+	client := r.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	var resp *http.Response
+	if resp, err = client.Do(req); err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		err = fmt.Errorf("%s: %w", dot, objectid.ErrNotFound)
+		return
+	case resp.StatusCode != http.StatusOK:
+		err = fmt.Errorf("Unexpected HTTP status for %s: %s", dot, resp.Status)
+		return
+	}
+
+	body := io.LimitReader(resp.Body, MaxResponseSize)
+	if r.Decode != nil {
+		return r.Decode(dot, body)
+	}
+
+	return decodeJSON(dot, body)
+}
+
+/*
+url returns the endpoint of the receiver with all placeholders replaced
+by renderings of dot.
+*/
+func (r *HTTPResolver) url(dot objectid.DotNotation) string {
+	s := dot.String()
+	return strings.NewReplacer(
+		PlaceholderDot, s,
+		PlaceholderPath, strings.ReplaceAll(s, `.`, `/`),
+	).Replace(r.Endpoint)
+}
+
+/*
+jsonRecord is the default JSON response form.
+*/
+type jsonRecord struct {
+	OID         string `json:"oid"`
+	Identifier  string `json:"identifier"`
+	Description string `json:"description"`
+}
+
+/*
+decodeJSON returns the [objectid.Record] describing dot decoded from the
+JSON object within body, alongside an error.
+*/
+func decodeJSON(dot objectid.DotNotation, body io.Reader) (rec objectid.Record, err error) {
+	var jr jsonRecord
+	if err = json.NewDecoder(body).Decode(&jr); err != nil {
+		err = fmt.Errorf("Invalid response for %s: %v", dot, err)
+		return
+	} else if len(jr.OID) > 0 && !dot.Equal(jr.OID) {
+		err = fmt.Errorf("Response OID %s does not match requested %s", jr.OID, dot)
+		return
+	} else if len(jr.Identifier) > 0 && !objectid.IsIdentifier(jr.Identifier) {
+		err = fmt.Errorf("Response bears an invalid identifier '%s'", jr.Identifier)
+		return
+	} else if len(jr.Identifier) == 0 && len(jr.Description) == 0 {
+		err = fmt.Errorf("%s: %w", dot, objectid.ErrNotFound)
+		return
+	}
+
+	// Parse a fresh copy, so that the record
+	// shares no storage with the caller's dot.
+	var d *objectid.DotNotation
+	if d, err = objectid.NewDotNotation(dot.String()); err != nil {
+		err = fmt.Errorf("Invalid OID %s: %v", dot, err)
+		return
+	}
+
+	rec = objectid.Record{
+		Dot:         *d,
+		Identifier:  jr.Identifier,
+		Description: jr.Description,
+	}
+
+	return
+}
+
+var _ objectid.RemoteResolver = (*HTTPResolver)(nil)
@@ -0,0 +1,110 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oid-directory/go-objectid"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case `/oid/1.3.6.1.4.1.56521.json`, `/arc/1/3/6/1/4/1/56521`:
+			if req.Header.Get(`X-Token`) != `secret` {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			fmt.Fprint(w, `{"oid":"1.3.6.1.4.1.56521","identifier":"example","description":"Example enterprise"}`)
+		case `/oid/2.999.1.json`:
+			fmt.Fprint(w, `{"oid":"2.999.2","identifier":"wrong"}`)
+		case `/oid/2.999.2.json`:
+			fmt.Fprint(w, `{"identifier":"Bogus_ID"}`)
+		case `/oid/2.999.3.json`:
+			fmt.Fprint(w, `not json`)
+		case `/oid/2.999.4.json`:
+			<-req.Context().Done()
+		case `/oid/2.999.5.json`:
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			http.NotFound(w, req)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestHTTPResolver(t *testing.T) {
+	srv := newTestServer(t)
+	dot := objectid.MustNewDotNotation(`1.3.6.1.4.1.56521`)
+
+	for _, pattern := range []string{`/oid/{oid}.json`, `/arc/{path}`} {
+		r, err := NewHTTPResolver(srv.URL + pattern)
+		if err != nil {
+			t.Fatalf("%s failed: %v", t.Name(), err)
+		}
+		r.Header = http.Header{`X-Token`: {`secret`}}
+
+		rec, err := r.Resolve(context.Background(), *dot)
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if !rec.Dot.Equal(dot) || rec.Identifier != `example` || rec.Description != `Example enterprise` {
+			t.Errorf("%s failed: unexpected record %v", t.Name(), rec)
+		}
+	}
+
+	r, _ := NewHTTPResolver(srv.URL + `/oid/{oid}.json`)
+	if _, err := r.Resolve(context.Background(), *objectid.MustNewDotNotation(`2.999.9`)); !errors.Is(err, objectid.ErrNotFound) {
+		t.Errorf("%s failed: want ErrNotFound, got %v", t.Name(), err)
+	}
+
+	for _, bogus := range []string{`2.999.1`, `2.999.2`, `2.999.3`, `2.999.5`, `1.3.6.1.4.1.56521`} {
+		if _, err := r.Resolve(context.Background(), *objectid.MustNewDotNotation(bogus)); err == nil {
+			t.Errorf("%s failed: expected error for %s, got nothing", t.Name(), bogus)
+		} else if errors.Is(err, objectid.ErrNotFound) {
+			t.Errorf("%s failed: unexpected ErrNotFound for %s", t.Name(), bogus)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := r.Resolve(ctx, *objectid.MustNewDotNotation(`2.999.4`)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("%s failed: want deadline exceeded, got %v", t.Name(), err)
+	}
+
+	if _, err := NewHTTPResolver(`https://example.com/oid`); err == nil {
+		t.Errorf("%s failed: expected error for endpoint without placeholder", t.Name())
+	}
+}
+
+func TestHTTPResolver_Decode(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, "example|Example arc")
+	}))
+	defer srv.Close()
+
+	r, _ := NewHTTPResolver(srv.URL + `/{oid}`)
+	r.Decode = func(dot objectid.DotNotation, body io.Reader) (rec objectid.Record, err error) {
+		var b []byte
+		if b, err = io.ReadAll(body); err == nil {
+			rec.Dot = dot
+			rec.Identifier, rec.Description, _ = strings.Cut(string(b), `|`)
+		}
+		return
+	}
+
+	rec, err := r.Resolve(context.Background(), *objectid.MustNewDotNotation(`2.999`))
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if rec.Identifier != `example` || rec.Description != `Example arc` {
+		t.Errorf("%s failed: unexpected record %v", t.Name(), rec)
+	}
+}
@@ -0,0 +1,41 @@
+package objectid
+
+/*
+resolver.go defines the RemoteResolver interface, through which records
+for unknown OIDs may be obtained on demand.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+/*
+ErrNotFound is returned, possibly wrapped, by [RemoteResolver] instances
+when no [Record] exists for the requested [DotNotation]. Use [errors.Is]
+to test for this condition.
+*/
+var ErrNotFound = errors.New("OID not found")
+
+/*
+RemoteResolver is implemented by types which obtain a [Record] describing
+a [DotNotation] from an external source, such as a web service or remote
+directory, allowing unknown OIDs to be described on demand.
+
+Implementations return an error wrapping [ErrNotFound] if no record exists
+for dot, and should honor the cancellation and deadline of ctx. A reference
+HTTP implementation is available within the remote subpackage, keeping this
+package free of network dependencies.
+*/
+type RemoteResolver interface {
+	Resolve(ctx context.Context, dot DotNotation) (Record, error)
+}
+
+/*
+notFound returns an error wrapping [ErrNotFound] for the OID whose dot
+notation is key.
+*/
+func notFound(key string) error {
+	return fmt.Errorf("%s: %w", key, ErrNotFound)
+}
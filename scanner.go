@@ -0,0 +1,146 @@
+package objectid
+
+/*
+scanner.go implements a Scanner for streams of ASN.1 encoded OIDs.
+*/
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+/*
+Scanner reads successive ASN.1 OBJECT IDENTIFIER encodings from an
+[io.Reader], such as a file of concatenated DER-encoded OIDs, decoding
+each into a [DotNotation] instance.
+
+Use of this type is similar to that of [bufio.Scanner]: successive calls
+of [Scanner.Scan] advance through the stream, after which the current
+value is obtained using [Scanner.DotNotation]. Once [Scanner.Scan] returns
+false, [Scanner.Err] reports the error (if any) that halted scanning. A
+clean end of stream is not considered an error.
+
+Instances of this type are not safe for concurrent use.
+*/
+type Scanner struct {
+	rd     *bufio.Reader
+	opts   []EncodingOption
+	cfg    *encodingConfig
+	dot    DotNotation
+	err    error
+	record int
+	offset int64
+	done   bool
+}
+
+/*
+NewScanner returns a new instance of *[Scanner] reading from rd. Zero or
+more instances of [EncodingOption] may be provided, and are applied when
+decoding each record. The limits described by the [DotNotation.Decode]
+method are enforced before each record is read into memory.
+*/
+func NewScanner(rd io.Reader, opts ...EncodingOption) *Scanner {
+	return &Scanner{rd: bufio.NewReader(rd), opts: opts, cfg: newEncodingConfig(opts...)}
+}
+
+/*
+Scan advances the receiver to the next encoded OID, which will then be
+available through the [Scanner.DotNotation] method. A Boolean value of
+false is returned when the end of the stream is reached, or when an error
+is encountered.
+*/
+func (r *Scanner) Scan() bool {
+	if r.done {
+		return false
+	}
+
+	r.dot = nil
+	tlv, err := r.readTLV()
+	if err == io.EOF {
+		r.done = true
+		return false
+	} else if err == nil {
+		r.dot, _, err = DecodeNext(tlv, r.opts...)
+	}
+
+	if err != nil {
+		r.err = errorf("Record %d at offset %d: %s", r.record, r.offset, err.Error())
+		r.done = true
+		return false
+	}
+
+	r.record++
+	r.offset += int64(len(tlv))
+
+	return true
+}
+
+/*
+readTLV reads a single complete tag-length-value sequence from the
+underlying reader. io.EOF is returned only if the stream ends cleanly
+before a new record begins.
+*/
+func (r *Scanner) readTLV() (tlv []byte, err error) {
+	tlv = make([]byte, 2)
+	var n int
+	if n, err = io.ReadFull(r.rd, tlv); err != nil {
+		if !(err == io.EOF && n == 0) {
+			err = errorf("Truncated OID encoding")
+		}
+		return
+	}
+
+	if tlv[1]&0x80 != 0 {
+		if octets := int(tlv[1] & 0x7F); 0 < octets && octets <= 4 {
+			lb := make([]byte, octets)
+			if _, err = io.ReadFull(r.rd, lb); err != nil {
+				err = errorf("Truncated OID encoding")
+				return
+			}
+			tlv = append(tlv, lb...)
+		}
+	}
+
+	var length int
+	if length, _, err = readLength(tlv[1:]); err != nil {
+		return
+	} else if err = r.cfg.checkContentLength(length); err != nil {
+		return
+	}
+
+	// Grow the buffer only as content arrives, such that an
+	// untrusted length cannot force a large allocation, even
+	// when no content length limit is in effect.
+	buf := bytes.NewBuffer(tlv)
+	if _, err = io.CopyN(buf, r.rd, int64(length)); err != nil {
+		err = errorf("Truncated OID encoding")
+		return
+	}
+	tlv = buf.Bytes()
+
+	return
+}
+
+/*
+DotNotation returns the most recent [DotNotation] decoded by [Scanner.Scan].
+*/
+func (r *Scanner) DotNotation() DotNotation {
+	return r.dot
+}
+
+/*
+Err returns the first error encountered by the receiver, if any. The error
+identifies the zero-based record number and byte offset at which the failed
+record began.
+*/
+func (r *Scanner) Err() error {
+	return r.err
+}
+
+/*
+Records returns the integer number of OIDs successfully scanned thus far.
+*/
+func (r *Scanner) Records() int {
+	return r.record
+}
@@ -0,0 +1,83 @@
+package objectid
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"testing"
+)
+
+func ExampleScanner() {
+	// pre-encoded bytes for OIDs 1.3.6.1, 2.999 and 1.2
+	stream := bytes.NewReader([]byte{
+		0x06, 0x03, 0x2b, 0x06, 0x01,
+		0x06, 0x02, 0x88, 0x37,
+		0x06, 0x01, 0x2a,
+	})
+
+	scanner := NewScanner(stream)
+	for scanner.Scan() {
+		fmt.Println(scanner.DotNotation())
+	}
+
+	if err := scanner.Err(); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// 1.3.6.1
+	// 2.999
+	// 1.2
+}
+
+func TestScanner(t *testing.T) {
+	var buf bytes.Buffer
+	for _, d := range []string{
+		`1.3.6.1.4.1.56521`,
+		`2.25.987895962269883002155146617097157934`,
+		`0.0`,
+	} {
+		dot, _ := NewDotNotation(d)
+		b, _ := dot.Encode()
+		buf.Write(b)
+	}
+
+	scanner := NewScanner(&buf)
+	for scanner.Scan() {
+	}
+	if err := scanner.Err(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if scanner.Records() != 3 {
+		t.Errorf("%s failed: want 3 records, got %d", t.Name(), scanner.Records())
+	}
+
+	for idx, bogus := range [][]byte{
+		{0x06, 0x03, 0x2b, 0x06, 0x01, 0x05},
+		{0x06, 0x03, 0x2b, 0x06, 0x01, 0x06, 0x03, 0x2b},
+		{0x06, 0x03, 0x2b, 0x06, 0x01, 0x05, 0x00},
+		{0x06, 0x03, 0x2b, 0x06, 0x01, 0x06, 0x82, 0x01},
+	} {
+		scanner = NewScanner(bytes.NewReader(bogus))
+		for scanner.Scan() {
+		}
+		if scanner.Err() == nil {
+			t.Errorf("%s[%d] failed: expected error, got nothing", t.Name(), idx)
+		} else if scanner.Records() != 1 {
+			t.Errorf("%s[%d] failed: want 1 record, got %d", t.Name(), idx, scanner.Records())
+		}
+	}
+
+	// A hostile length, absent any limit, must not be allocated
+	// before the content it claims actually arrives.
+	hostile := []byte{0x06, 0x84, 0x7f, 0xff, 0xff, 0xff, 0x2b, 0x06}
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	scanner = NewScanner(bytes.NewReader(hostile), WithMaxContentLength(0))
+	for scanner.Scan() {
+	}
+	runtime.ReadMemStats(&after)
+	if scanner.Err() == nil {
+		t.Errorf("%s failed: expected truncation error, got nothing", t.Name())
+	} else if n := after.TotalAlloc - before.TotalAlloc; n > 1<<20 {
+		t.Errorf("%s failed: %d bytes allocated for truncated record", t.Name(), n)
+	}
+}
@@ -0,0 +1,64 @@
+package objectid
+
+/*
+sql.go implements the database/sql interfaces for DotNotation values.
+*/
+
+import "database/sql/driver"
+
+/*
+Value implements the [database/sql/driver.Valuer] interface, returning the
+dot notation string form of the receiver (e.g.: "1.3.6.1") for storage
+within a text column. A zero receiver is stored as NULL.
+
+Use [DotNotation.Encode] to obtain a value suitable for binary columns,
+as [DotNotation.Scan] accepts either form.
+*/
+func (r DotNotation) Value() (driver.Value, error) {
+	if r.Len() == 0 {
+		return nil, nil
+	}
+
+	return r.String(), nil
+}
+
+/*
+Scan implements the [database/sql.Scanner] interface, setting the receiver
+to the OID described by src. Valid source types are:
+
+  - string, read in the manner of [NewDotNotation]
+  - []byte bearing the ASN.1 encoding of an OID, as produced by
+    [DotNotation.Encode], such as from a BYTEA or BLOB column
+  - []byte bearing a dot notation string, such as from a TEXT column
+    read through a driver which returns text as []byte
+  - nil (NULL), which yields a zero receiver
+
+The receiver is left unmodified upon error.
+*/
+func (r *DotNotation) Scan(src any) (err error) {
+	switch tv := src.(type) {
+	case nil:
+		*r = nil
+	case string:
+		var d *DotNotation
+		if d, err = NewDotNotation(tv); err == nil {
+			*r = *d
+		}
+	case []byte:
+		if len(tv) > 0 && tv[0] == 0x06 {
+			// Dot notation cannot begin with the
+			// OBJECT IDENTIFIER tag, which is not
+			// printable, so assume an encoding.
+			var d DotNotation
+			if err = d.Decode(tv); err == nil {
+				*r = d
+			}
+			break
+		}
+		err = r.Scan(string(tv))
+	default:
+		err = errorf("Unsupported %T scan source type %T", r, src)
+	}
+
+	return
+}
@@ -0,0 +1,73 @@
+package objectid
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"testing"
+)
+
+func ExampleDotNotation_Scan() {
+	var dot DotNotation
+	if err := dot.Scan([]byte{0x06, 0x03, 0x2b, 0x06, 0x01}); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	val, _ := dot.Value()
+	fmt.Println(val)
+	// Output: 1.3.6.1
+}
+
+func TestDotNotation_sql(t *testing.T) {
+	var (
+		_ driver.Valuer = DotNotation{}
+		_ sql.Scanner   = &DotNotation{}
+	)
+
+	want := mustDot(`2.25.987895962269883002155146617097157934`)
+	der, _ := want.Encode()
+
+	for _, src := range []any{
+		want.String(),
+		[]byte(want.String()),
+		der,
+		`urn:oid:` + want.String(),
+	} {
+		var got DotNotation
+		if err := got.Scan(src); err != nil {
+			t.Errorf("%s failed for %T: %v", t.Name(), src, err)
+		} else if got.String() != want.String() {
+			t.Errorf("%s failed for %T: want %s, got %s", t.Name(), src, want, got)
+		}
+	}
+
+	// DER produced by Encode for zero-valued arcs must scan back
+	// intact.
+	for _, s := range []string{`2.25.0`, `0.0`, `1.3.0.6`, `1.3.6.1.2.1.1.3.0`} {
+		var got DotNotation
+		der, _ := mustDot(s).Encode()
+		if err := got.Scan(der); err != nil {
+			t.Errorf("%s failed for %s: %v", t.Name(), s, err)
+		} else if got.String() != s {
+			t.Errorf("%s failed: want %s, got %s", t.Name(), s, got)
+		}
+	}
+
+	got := mustDot(`2.999`)
+	for _, src := range []any{`3.1`, []byte{0x06, 0x01}, 42} {
+		if err := got.Scan(src); err == nil {
+			t.Errorf("%s failed: expected error for %#v", t.Name(), src)
+		} else if got.String() != `2.999` {
+			t.Errorf("%s failed: receiver modified upon error", t.Name())
+		}
+	}
+
+	if err := got.Scan(nil); err != nil || got.Len() != 0 {
+		t.Errorf("%s failed: expected zero value from NULL (err: %v)", t.Name(), err)
+	} else if val, err := got.Value(); val != nil || err != nil {
+		t.Errorf("%s failed: expected NULL from zero value, got %v (err: %v)", t.Name(), val, err)
+	} else if val, _ = want.Value(); val != want.String() {
+		t.Errorf("%s failed: want %s, got %v", t.Name(), want, val)
+	}
+}
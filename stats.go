@@ -0,0 +1,82 @@
+package objectid
+
+/*
+stats.go implements package-wide operation counters for observability.
+*/
+
+import "sync/atomic"
+
+/*
+OperationStats contains the package-wide operation counters, as returned
+by the [ReadOperationStats] function. Each counter is cumulative since
+program start, or since the last call of [ResetOperationStats].
+
+Operations performed internally by this package (e.g.: a [ParseCache]
+miss, which parses its input using [NewDotNotation]) are counted as well.
+*/
+type OperationStats struct {
+	Parses         uint64 // calls of NewDotNotation
+	ParseFailures  uint64 // calls of NewDotNotation which returned an error
+	Encodes        uint64 // calls of DotNotation.Encode
+	EncodeFailures uint64 // calls of DotNotation.Encode which returned an error
+	Decodes        uint64 // calls of DotNotation.Decode and DecodeNext
+	DecodeFailures uint64 // calls of DotNotation.Decode and DecodeNext which returned an error
+	CacheHits      uint64 // ParseCache lookups satisfied by a cache
+	CacheMisses    uint64 // ParseCache lookups which required parsing
+}
+
+/*
+opStats contains the live counters underlying [OperationStats].
+*/
+var opStats struct {
+	parses, parseFailures   atomic.Uint64
+	encodes, encodeFailures atomic.Uint64
+	decodes, decodeFailures atomic.Uint64
+	cacheHits, cacheMisses  atomic.Uint64
+}
+
+/*
+ReadOperationStats returns an instance of [OperationStats] bearing the
+current values of the package-wide operation counters, allowing operators
+of high-volume services to monitor OID handling (e.g.: by exporting these
+values as metrics) without wrapping every call. This function is safe for
+concurrent use, though counters are read individually rather than as an
+atomic snapshot.
+*/
+func ReadOperationStats() OperationStats {
+	return OperationStats{
+		Parses:         opStats.parses.Load(),
+		ParseFailures:  opStats.parseFailures.Load(),
+		Encodes:        opStats.encodes.Load(),
+		EncodeFailures: opStats.encodeFailures.Load(),
+		Decodes:        opStats.decodes.Load(),
+		DecodeFailures: opStats.decodeFailures.Load(),
+		CacheHits:      opStats.cacheHits.Load(),
+		CacheMisses:    opStats.cacheMisses.Load(),
+	}
+}
+
+/*
+ResetOperationStats zeroes all package-wide operation counters.
+*/
+func ResetOperationStats() {
+	for _, c := range []*atomic.Uint64{
+		&opStats.parses, &opStats.parseFailures,
+		&opStats.encodes, &opStats.encodeFailures,
+		&opStats.decodes, &opStats.decodeFailures,
+		&opStats.cacheHits, &opStats.cacheMisses,
+	} {
+		c.Store(0)
+	}
+}
+
+/*
+countOp increments total and, if err is non-nil, failures. It is meant
+to be deferred with a pointer to the named error result of the operation.
+*/
+func countOp(total, failures *atomic.Uint64, err *error) {
+	total.Add(1)
+	if *err != nil {
+		failures.Add(1)
+	}
+}
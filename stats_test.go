@@ -0,0 +1,53 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleReadOperationStats() {
+	ResetOperationStats()
+
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	_, _ = NewDotNotation(`bogus`)
+	_, _ = dot.Encode()
+
+	st := ReadOperationStats()
+	fmt.Printf("parses=%d failures=%d encodes=%d\n", st.Parses, st.ParseFailures, st.Encodes)
+	// Output: parses=2 failures=1 encodes=1
+}
+
+func TestOperationStats(t *testing.T) {
+	ResetOperationStats()
+
+	dot := mustDot(`2.999.1`)
+	enc, _ := dot.Encode()
+	_, _ = DotNotation{}.Encode()
+
+	var d DotNotation
+	_ = d.Decode(enc)
+	_ = d.Decode([]byte{0x06})
+	_, _, _ = DecodeNext(enc)
+
+	cache := NewParseCache(4)
+	_, _ = cache.Parse(`1.3.6`)
+	_, _ = cache.Parse(`1.3.6`)
+
+	want := OperationStats{
+		Parses:         2, // mustDot and the cache miss
+		Encodes:        2,
+		EncodeFailures: 1,
+		Decodes:        3,
+		DecodeFailures: 1,
+		CacheHits:      1,
+		CacheMisses:    1,
+	}
+	if got := ReadOperationStats(); got != want {
+		t.Errorf("%s failed:\nwant %+v\ngot  %+v", t.Name(), want, got)
+	}
+
+	ResetOperationStats()
+	if got := ReadOperationStats(); got != (OperationStats{}) {
+		t.Errorf("%s failed: counters not reset: %+v", t.Name(), got)
+	}
+}
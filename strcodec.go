@@ -0,0 +1,103 @@
+package objectid
+
+/*
+strcodec.go provides hexadecimal and base64 string forms of the ASN.1
+encoding of a DotNotation.
+*/
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+)
+
+/*
+EncodeHexString returns the ASN.1 encoding of the receiver, as produced
+by the [DotNotation.Encode] method, as a lowercase hexadecimal string
+(e.g.: "06032b0601" for 1.3.6.1) alongside an error.
+*/
+func (r DotNotation) EncodeHexString(opts ...EncodingOption) (s string, err error) {
+	var b []byte
+	if b, err = r.Encode(opts...); err == nil {
+		s = hex.EncodeToString(b)
+	}
+
+	return
+}
+
+/*
+DecodeHexString returns an error following an attempt to decode the
+hexadecimal string s into the receiver in the manner of the
+[DotNotation.Decode] method. The receiver instance is reinitialized at
+runtime.
+
+As encodings are frequently copied from logs and dumps, the following are
+tolerated: a leading "0x" prefix, upper or lower case digits, and octets
+delimited by whitespace or colons (e.g.: "06:03:2B:06:01").
+*/
+func (r *DotNotation) DecodeHexString(s string, opts ...EncodingOption) (err error) {
+	var b []byte
+	if b, err = hex.DecodeString(stripHexDelimiters(s)); err != nil {
+		err = errorf("Invalid hexadecimal encoding: %v", err)
+		return
+	}
+
+	return r.Decode(b, opts...)
+}
+
+/*
+stripHexDelimiters returns s following the removal of any "0x" prefix,
+whitespace and colons.
+*/
+func stripHexDelimiters(s string) string {
+	s = trimS(s)
+	if len(s) > 1 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		s = s[2:]
+	}
+
+	return mapRunes(func(c rune) rune {
+		if c == ':' || isSpace(c) {
+			return -1
+		}
+		return c
+	}, s)
+}
+
+/*
+EncodeBase64String returns the ASN.1 encoding of the receiver, as produced
+by the [DotNotation.Encode] method, as a padded standard base64 string
+(e.g.: "BgMrBgE=" for 1.3.6.1) per RFC 4648, alongside an error. This is
+the form used by LDIF ("attr:: value") and most JSON serializers.
+*/
+func (r DotNotation) EncodeBase64String(opts ...EncodingOption) (s string, err error) {
+	var b []byte
+	if b, err = r.Encode(opts...); err == nil {
+		s = base64.StdEncoding.EncodeToString(b)
+	}
+
+	return
+}
+
+/*
+DecodeBase64String returns an error following an attempt to decode the
+base64 string s into the receiver in the manner of the [DotNotation.Decode]
+method. The receiver instance is reinitialized at runtime.
+
+Both the standard and URL-safe alphabets of RFC 4648 are accepted, with
+or without padding. Surrounding whitespace is ignored.
+*/
+func (r *DotNotation) DecodeBase64String(s string, opts ...EncodingOption) (err error) {
+	s = trimR(trimS(s), `=`)
+
+	enc := base64.RawStdEncoding
+	if indexAny(s, `-_`) != -1 {
+		enc = base64.RawURLEncoding
+	}
+
+	var b []byte
+	if b, err = enc.DecodeString(s); err != nil {
+		err = errorf("Invalid base64 encoding: %v", err)
+		return
+	}
+
+	return r.Decode(b, opts...)
+}
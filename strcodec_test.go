@@ -0,0 +1,76 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleDotNotation_EncodeHexString() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	h, _ := dot.EncodeHexString()
+	b64, _ := dot.EncodeBase64String()
+
+	fmt.Println(h, b64)
+	// Output: 06082b0601040183b949 BggrBgEEAYO5SQ==
+}
+
+func ExampleDotNotation_DecodeHexString() {
+	var dot DotNotation
+	if err := dot.DecodeHexString(`06:03:2B:06:01`); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(dot)
+	// Output: 1.3.6.1
+}
+
+func TestDotNotation_stringCodecs(t *testing.T) {
+	for _, hexIn := range []string{
+		`06032b0601`,
+		`0x06032B0601`,
+		" 06 03 2b 06 01\n",
+		`06:03:2b:06:01`,
+	} {
+		var dot DotNotation
+		if err := dot.DecodeHexString(hexIn); err != nil || dot.String() != `1.3.6.1` {
+			t.Errorf("%s failed: want 1.3.6.1, got %s (%v)", t.Name(), dot, err)
+		}
+	}
+
+	dot := mustDot(`2.25.329800735698586629295641978511506172918`)
+	b64, err := dot.EncodeBase64String()
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	for _, in := range []string{
+		b64,
+		` ` + b64 + "\n",
+		trimR(b64, `=`),
+		`BhRpg_Cdp-vP3uDHoaeywJSMyPnXdg`,
+	} {
+		var d DotNotation
+		if err = d.DecodeBase64String(in); err != nil || !d.Equal(dot) {
+			t.Errorf("%s failed: want %s, got %s (%v)", t.Name(), dot, d, err)
+		}
+	}
+
+	var d DotNotation
+	for _, bogus := range []string{`06032g0601`, `06032b06`, `0603 2`} {
+		if err = d.DecodeHexString(bogus); err == nil {
+			t.Errorf("%s failed: bogus hex '%s' decoded without error", t.Name(), bogus)
+		}
+	}
+	for _, bogus := range []string{`!!!!`, `BgMrBg`, `BgMr+gE_`} {
+		if err = d.DecodeBase64String(bogus); err == nil {
+			t.Errorf("%s failed: bogus base64 '%s' decoded without error", t.Name(), bogus)
+		}
+	}
+
+	if _, err = (DotNotation{}).EncodeHexString(); err == nil {
+		t.Errorf("%s failed: zero value encoded without error", t.Name())
+	} else if _, err = (DotNotation{}).EncodeBase64String(); err == nil {
+		t.Errorf("%s failed: zero value encoded without error", t.Name())
+	}
+}
@@ -0,0 +1,85 @@
+package objectid
+
+/*
+subtree.go implements the RegistrySubtree type, a view of the records
+residing at or beneath a given arc of a Registry.
+*/
+
+/*
+RegistrySubtree is a read-only view of the [Record] instances residing
+at or beneath a base [DotNotation] within a [Registry], as returned by
+the [Registry.Subtree] method. The view is live: it reflects the contents
+of the underlying [Registry] at the time each of its methods is called.
+*/
+type RegistrySubtree struct {
+	reg   *Registry
+	base  DotNotation
+	valid bool
+}
+
+/*
+Subtree returns a [RegistrySubtree] bearing the records of the receiver
+residing at or beneath base, which can be a string or [DotNotation]. A
+root arc alone (e.g.: "1") is accepted, while a nil or zero string value
+results in a view of all records. Should base be invalid, the view
+returned bears no records.
+*/
+func (r *Registry) Subtree(base any) (s RegistrySubtree) {
+	s.reg = r
+	if s.valid = base == nil || base == ``; !s.valid {
+		s.base, s.valid = registryDot(base)
+	}
+
+	return
+}
+
+/*
+exportSubtree returns the [RegistrySubtree] of the receiver identified by
+subtree in the manner of [Registry.Subtree], alongside an error naming the
+export format should subtree be invalid.
+*/
+func (r *Registry) exportSubtree(subtree any, format string) (s RegistrySubtree, err error) {
+	if s = r.Subtree(subtree); !s.valid {
+		err = errorf("Invalid %s export subtree: %v", format, subtree)
+	}
+
+	return
+}
+
+/*
+Base returns the base [DotNotation] of the receiver.
+*/
+func (r RegistrySubtree) Base() DotNotation {
+	return r.base.clone()
+}
+
+/*
+Records returns all [Record] instances within the receiver, ordered in
+the manner described by the [Registry.Records] method.
+*/
+func (r RegistrySubtree) Records() (recs []Record) {
+	r.visit(func(dot DotNotation, entry *regEntry) bool {
+		recs = append(recs, entry.record(dot))
+		return true
+	})
+
+	return
+}
+
+/*
+visit calls fn with the complete [DotNotation] and entry of each record
+within the receiver while holding the read lock of the underlying
+[Registry], ceasing once fn returns false.
+*/
+func (r RegistrySubtree) visit(fn func(DotNotation, *regEntry) bool) {
+	if !r.valid || r.reg == nil {
+		return
+	}
+
+	r.reg.mu.RLock()
+	defer r.reg.mu.RUnlock()
+
+	if node, path := r.reg.root.subtree(r.base); node != nil {
+		node.visit(path, fn)
+	}
+}
@@ -0,0 +1,52 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleRegistry_Subtree() {
+	reg := NewRegistry()
+	for _, dot := range []string{`1.3.6.1.4.1`, `1.3.6.1.4.1.56521`, `1.3.6.1.4.1.56521.999`, `2.25`} {
+		_ = reg.Register(Record{Dot: mustDot(dot)})
+	}
+
+	for _, rec := range reg.Subtree(`1.3.6.1.4.1.56521`).Records() {
+		fmt.Println(rec.Dot)
+	}
+	// Output:
+	// 1.3.6.1.4.1.56521
+	// 1.3.6.1.4.1.56521.999
+}
+
+func TestRegistry_Subtree(t *testing.T) {
+	reg := newTestRegistry(t)
+
+	for _, tc := range []struct {
+		base any
+		want string
+	}{
+		{nil, `[1.3 1.3.6.1.4.1 1.3.6.1.4.1.56521 1.3.6.1.4.1.56521.2 1.3.6.1.4.1.56521.999 2.25]`},
+		{``, `[1.3 1.3.6.1.4.1 1.3.6.1.4.1.56521 1.3.6.1.4.1.56521.2 1.3.6.1.4.1.56521.999 2.25]`},
+		{`1`, `[1.3 1.3.6.1.4.1 1.3.6.1.4.1.56521 1.3.6.1.4.1.56521.2 1.3.6.1.4.1.56521.999]`},
+		{`1.3.6`, `[1.3.6.1.4.1 1.3.6.1.4.1.56521 1.3.6.1.4.1.56521.2 1.3.6.1.4.1.56521.999]`},
+		{`1.3.6.1.4.1.56521`, `[1.3.6.1.4.1.56521 1.3.6.1.4.1.56521.2 1.3.6.1.4.1.56521.999]`},
+		{mustDot(`1.3.6.1.4.1.56521.999`), `[1.3.6.1.4.1.56521.999]`},
+		{`1.3.6.1.4.1.56521.3`, `[]`},
+		{`1.3.7`, `[]`},
+		{`2.25.1`, `[]`},
+		{`bogus`, `[]`},
+	} {
+		var dots []string
+		for _, rec := range reg.Subtree(tc.base).Records() {
+			dots = append(dots, rec.Dot.String())
+		}
+		if got := fmt.Sprint(dots); got != tc.want {
+			t.Errorf("%s failed for %v:\n\twant: %s\n\tgot:  %s", t.Name(), tc.base, tc.want, got)
+		}
+	}
+
+	if base := reg.Subtree(`1.3.6`).Base(); base.String() != `1.3.6` {
+		t.Errorf("%s failed: want base 1.3.6, got %s", t.Name(), base)
+	}
+}
@@ -0,0 +1,272 @@
+package objectid
+
+/*
+table.go implements tabular (CSV and TSV) interchange of Registry contents.
+*/
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"time"
+)
+
+/*
+ImportTable reads rows of delimited text from rd into the receiver, each
+describing a single [Record] in the manner of a spreadsheet or inventory
+export, returning an error if the operation fails. By default, each row
+bears comma-delimited oid, identifier and description columns, in that
+order. See [WithDelimiter], [WithHeader], [WithColumns],
+[WithColumnNames] and [WithAuthority] to alter this behavior.
+
+Each oid column value may bear any spelling accepted by [NewDotNotation]
+(e.g.: "urn:oid:1.3.6.1"), or may be a root arc alone. Surrounding
+whitespace is ignored. Records imported replace any existing records
+bearing the same [DotNotation], and new records are subject to the
+[Constraint] checks of [Registry.Register]; upon error, the receiver is
+left unmodified, unless [WithStreaming] is specified.
+*/
+func (r *Registry) ImportTable(rd io.Reader, opts ...TableOption) error {
+	return r.ImportTableContext(context.Background(), rd, opts...)
+}
+
+/*
+ImportTableContext is the same as [Registry.ImportTable], except that the
+import ceases with the error of ctx once ctx is done, leaving the receiver
+unmodified unless [WithStreaming] is specified.
+*/
+func (r *Registry) ImportTableContext(ctx context.Context, rd io.Reader, opts ...TableOption) (err error) {
+	cfg := newTableConfig(opts...)
+	if cfg.streaming {
+		return readTable(ctx, rd, cfg, func(rec Record) (err error) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			if err = r.checkChild(rec.Dot); err == nil {
+				r.store(rec)
+			}
+			return
+		})
+	}
+
+	var recs []Record
+	if err = readTable(ctx, rd, cfg, func(rec Record) error {
+		recs = append(recs, rec)
+		return nil
+	}); err != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	err = r.storeAll(recs)
+
+	return
+}
+
+/*
+ReadTable reads rows of delimited text from rd in the manner of
+[Registry.ImportTable], calling fn with the [Record] described by each
+row as it is read. Only a single row is held in memory at any time,
+making this suitable for very large sources, such as full inventory
+exports, which need not be retained in their entirety.
+
+Reading ceases upon the first error returned by fn, which is returned
+as-is, or once ctx is done. See [WithProgress] to monitor long reads.
+*/
+func ReadTable(ctx context.Context, rd io.Reader, fn func(Record) error, opts ...TableOption) error {
+	return readTable(ctx, rd, newTableConfig(opts...), fn)
+}
+
+func readTable(ctx context.Context, rd io.Reader, cfg *tableConfig, fn func(Record) error) (err error) {
+	if err = cfg.err; err != nil {
+		return
+	}
+
+	cr := csv.NewReader(rd)
+	cr.Comma = cfg.comma
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	cr.ReuseRecord = true
+
+	var rows int64
+	for n := 0; ; n++ {
+		var row []string
+		if err = canceled(ctx); err != nil {
+			return
+		} else if row, err = cr.Read(); err == io.EOF {
+			err = nil
+			break
+		} else if err != nil {
+			return
+		}
+
+		if n == 0 && cfg.header {
+			if err = cfg.resolveColumns(row); err != nil {
+				return
+			}
+			continue
+		}
+
+		line, _ := cr.FieldPos(0)
+		var rec Record
+		if rec, err = cfg.record(row); err != nil {
+			err = errorf("Line %d: %v", line, err)
+			return
+		} else if err = fn(rec); err != nil {
+			return
+		}
+
+		if rows++; cfg.progress != nil && rows%int64(cfg.interval) == 0 {
+			cfg.progress(rows)
+		}
+	}
+
+	if cfg.progress != nil && rows%int64(cfg.interval) != 0 {
+		cfg.progress(rows)
+	}
+
+	return
+}
+
+/*
+record returns the [Record] described by row per the column mapping of
+the receiver, alongside an error.
+*/
+func (r *tableConfig) record(row []string) (rec Record, err error) {
+	col := func(idx int) (val string) {
+		if 0 <= idx && idx < len(row) {
+			val = trimS(row[idx])
+		}
+		return
+	}
+
+	dot := col(r.columns[0])
+	var ok bool
+	if rec.Dot, ok = parseArcKey(dot); !ok {
+		var d *DotNotation
+		if d, err = NewDotNotation(dot); err != nil {
+			return
+		}
+		rec.Dot = *d
+	}
+
+	rec.Identifier = col(r.columns[1])
+	rec.Description = col(r.columns[2])
+	if r.authority {
+		if rec.Authority, err = r.authorityOf(col); err != nil {
+			return
+		}
+	}
+	err = rec.validate()
+
+	return
+}
+
+/*
+authorityOf returns the [RegistrationAuthority] described by the authority
+columns of a row, as returned by col, alongside an error. A nil instance
+is returned if the row bears no authority name.
+*/
+func (r *tableConfig) authorityOf(col func(int) string) (ra *RegistrationAuthority, err error) {
+	name := col(r.raColumns[0])
+	if len(name) == 0 {
+		return
+	}
+
+	ra = &RegistrationAuthority{
+		Name:    name,
+		Contact: col(r.raColumns[1]),
+		URL:     col(r.raColumns[2]),
+	}
+
+	if status := col(r.raColumns[3]); len(status) > 0 {
+		if ra.Status = parseRegistrationStatus(status); !eq(ra.Status.String(), status) {
+			err = errorf("Invalid %T '%s'", ra.Status, status)
+			return
+		}
+	}
+
+	for i, t := range []*time.Time{&ra.Created, &ra.Modified} {
+		if val := col(r.raColumns[4+i]); len(val) > 0 {
+			if *t, err = time.Parse(time.RFC3339, val); err != nil {
+				err = errorf("Invalid %s time '%s'", tableAuthorityColumns[4+i], val)
+				return
+			}
+		}
+	}
+
+	return
+}
+
+/*
+authorityRow returns the authority column values describing ra, which may
+be nil, in the manner of [WithAuthority].
+*/
+func authorityRow(ra *RegistrationAuthority) (row []string) {
+	row = make([]string, len(tableAuthorityColumns))
+	if ra == nil {
+		return
+	}
+
+	row[0], row[1], row[2] = ra.Name, ra.Contact, ra.URL
+	if ra.Status != StatusUnspecified {
+		row[3] = ra.Status.String()
+	}
+	for i, t := range []time.Time{ra.Created, ra.Modified} {
+		if !t.IsZero() {
+			row[4+i] = t.UTC().Format(time.RFC3339)
+		}
+	}
+
+	return
+}
+
+/*
+ExportTable writes all records within the receiver that reside at or
+beneath subtree to w as rows of delimited text bearing the oid, identifier
+and description columns, in that order, followed by the authority columns
+if [WithAuthority] is specified, returning an error if the operation fails.
+Values are quoted as needed per RFC 4180.
+
+The subtree is interpreted in the manner of [Registry.Subtree]. Only the
+[WithDelimiter], [WithHeader] and [WithAuthority] options are honored.
+Without [WithAuthority], the Authority of each [Record] is not written.
+*/
+func (r *Registry) ExportTable(w io.Writer, subtree any, opts ...TableOption) (err error) {
+	cfg := newTableConfig(opts...)
+	if err = cfg.err; err != nil {
+		return
+	}
+
+	var sub RegistrySubtree
+	if sub, err = r.exportSubtree(subtree, `table`); err != nil {
+		return
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Comma = cfg.comma
+	if cfg.header {
+		hdr := []string{`oid`, `identifier`, `description`}
+		if cfg.authority {
+			hdr = append(hdr, tableAuthorityColumns[:]...)
+		}
+		if err = cw.Write(hdr); err != nil {
+			return
+		}
+	}
+
+	recs := sub.Records()
+	for i := 0; i < len(recs); i++ {
+		row := []string{recs[i].Dot.String(), recs[i].Identifier, recs[i].Description}
+		if cfg.authority {
+			row = append(row, authorityRow(recs[i].Authority)...)
+		}
+		if err = cw.Write(row); err != nil {
+			return
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
@@ -0,0 +1,243 @@
+package objectid
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func ExampleRegistry_ImportTable() {
+	table := "Description\tOID\n" +
+		"Example enterprise\t1.3.6.1.4.1.56521\n" +
+		"Documentation arc\turn:oid:1.3.6.1.4.1.32473\n"
+
+	reg := NewRegistry()
+	err := reg.ImportTable(strings.NewReader(table),
+		WithDelimiter('\t'),
+		WithColumnNames(`oid`, ``, `description`))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	if err = reg.ExportTable(os.Stdout, nil, WithHeader()); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// oid,identifier,description
+	// 1.3.6.1.4.1.32473,,Documentation arc
+	// 1.3.6.1.4.1.56521,,Example enterprise
+}
+
+func TestRegistry_ImportTable(t *testing.T) {
+	reg := newTestRegistry(t)
+
+	var buf bytes.Buffer
+	if err := reg.ExportTable(&buf, nil); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	clone := NewRegistry()
+	if err := clone.ImportTable(&buf); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if clone.Len() != reg.Len() {
+		t.Fatalf("%s failed: want %d records, got %d", t.Name(), reg.Len(), clone.Len())
+	}
+
+	recs, got := reg.Records(), clone.Records()
+	for i := 0; i < len(recs); i++ {
+		if !recs[i].Dot.Equal(got[i].Dot) ||
+			recs[i].Identifier != got[i].Identifier ||
+			recs[i].Description != got[i].Description {
+			t.Errorf("%s failed: want %v, got %v", t.Name(), recs[i], got[i])
+		}
+	}
+
+	// Ragged rows, quoting, root arcs and reordered columns.
+	table := "x,\"Has, comma\",iso,1\n" +
+		"x,,,1.3.6\n" +
+		"x,\"Multi\nline\",dod,1.3.6.1\n"
+	reg = NewRegistry()
+	if err := reg.ImportTable(strings.NewReader(table), WithColumns(3, 2, 1)); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if rec, found := reg.Lookup(`1`); !found || rec.Identifier != `iso` || rec.Description != `Has, comma` {
+		t.Errorf("%s failed: unexpected root record %v", t.Name(), rec)
+	} else if rec, found = reg.Lookup(`1.3.6.1`); !found || rec.Description != "Multi\nline" {
+		t.Errorf("%s failed: unexpected record %v", t.Name(), rec)
+	}
+
+	buf.Reset()
+	if err := reg.ExportTable(&buf, `1.3.6`, WithDelimiter('\t')); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if want := "1.3.6\t\t\n1.3.6.1\tdod\t\"Multi\nline\"\n"; buf.String() != want {
+		t.Errorf("%s failed:\nwant %q\ngot  %q", t.Name(), want, buf.String())
+	}
+
+	for idx, bogus := range []struct {
+		table string
+		opts  []TableOption
+	}{
+		{"1.3.6\nbogus\n", nil},
+		{"1.3.6,Bogus_ID\n", nil},
+		{"3.1\n", nil},
+		{"a,b\n1.3.6,x\n", []TableOption{WithColumnNames(`oid`, `b`, ``)}},
+		{"1.3.6\n", []TableOption{WithDelimiter('"')}},
+		{"1.3.6\n", []TableOption{WithColumns(-1, 0, 0)}},
+		{"1.3.6\n", []TableOption{WithColumnNames(``, ``, ``)}},
+	} {
+		reg = NewRegistry()
+		if err := reg.ImportTable(strings.NewReader(bogus.table), bogus.opts...); err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nothing", t.Name(), idx)
+		} else if reg.Len() != 0 {
+			t.Errorf("%s[%d] failed: registry modified upon error", t.Name(), idx)
+		}
+	}
+
+	if err := reg.ExportTable(&buf, `bogus`); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+}
+
+func TestRegistry_ImportTable_authority(t *testing.T) {
+	created := time.Date(2019, 7, 1, 12, 0, 0, 0, time.UTC)
+	reg := NewRegistry()
+	for _, rec := range []Record{
+		{Dot: mustDot(`1.3.6.1.4.1.56521`), Identifier: `example`, Authority: &RegistrationAuthority{
+			Name:    `Example, Inc.`,
+			Contact: `hostmaster@example.com`,
+			URL:     `https://example.com/oids`,
+			Status:  StatusActive,
+			Created: created,
+		}},
+		{Dot: mustDot(`1.3.6.1.4.1.56521.1`)},
+	} {
+		if err := reg.Register(rec); err != nil {
+			t.Fatalf("%s failed: %v", t.Name(), err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := reg.ExportTable(&buf, nil, WithHeader(), WithAuthority()); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if want := "oid,identifier,description,authority,contact,url,status,created,modified\n" +
+		"1.3.6.1.4.1.56521,example,,\"Example, Inc.\",hostmaster@example.com,https://example.com/oids,active,2019-07-01T12:00:00Z,\n" +
+		"1.3.6.1.4.1.56521.1,,,,,,,,\n"; buf.String() != want {
+		t.Errorf("%s failed:\nwant %q\ngot  %q", t.Name(), want, buf.String())
+	}
+
+	for idx, opts := range [][]TableOption{
+		{WithHeader(), WithAuthority()},
+		{WithColumnNames(`oid`, `identifier`, ``), WithAuthority()},
+	} {
+		got := NewRegistry()
+		if err := got.ImportTable(bytes.NewReader(buf.Bytes()), opts...); err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+		} else if !reflect.DeepEqual(got.Records(), reg.Records()) {
+			t.Errorf("%s[%d] failed:\n\twant: %+v\n\tgot:  %+v", t.Name(), idx, reg.Records(), got.Records())
+		} else if ra, _, _ := got.Authority(`1.3.6.1.4.1.56521.1`); ra.URL != `https://example.com/oids` || !ra.Created.Equal(created) {
+			t.Errorf("%s[%d] failed: unexpected authority %+v", t.Name(), idx, ra)
+		}
+	}
+
+	// Without WithAuthority, authority columns are neither written nor read.
+	buf.Reset()
+	if err := reg.ExportTable(&buf, nil); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if strings.Contains(buf.String(), `Example`) {
+		t.Errorf("%s failed: authority exported without option:\n%s", t.Name(), buf.String())
+	}
+
+	for idx, bogus := range []string{
+		"2.999,,,Example,,,bogus\n",
+		"2.999,,,Example,,,,yesterday\n",
+		"2.999,,,,someone@example.com\n",
+	} {
+		got := NewRegistry()
+		err := got.ImportTable(strings.NewReader(bogus), WithAuthority())
+		if idx < 2 && err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nothing", t.Name(), idx)
+		} else if rec, _ := got.Lookup(`2.999`); idx == 2 && (err != nil || rec.Authority != nil) {
+			t.Errorf("%s[%d] failed: want nil authority, got %v (%v)", t.Name(), idx, rec.Authority, err)
+		}
+	}
+}
+
+func TestRegistry_ImportTableContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	reg := NewRegistry()
+	if err := reg.ImportTableContext(ctx, strings.NewReader("1.3.6,dod\n")); !errors.Is(err, context.Canceled) {
+		t.Errorf("%s failed: want context.Canceled, got %v", t.Name(), err)
+	} else if reg.Len() != 0 {
+		t.Errorf("%s failed: registry modified upon cancellation", t.Name())
+	}
+}
+
+func ExampleReadTable() {
+	table := "1.3.6.1.4.1.56521,example\n2.999,example\n2.25,uuid\n"
+
+	var count int
+	err := ReadTable(context.Background(), strings.NewReader(table), func(rec Record) error {
+		if rec.Identifier == `example` {
+			count++
+		}
+		return nil
+	}, WithProgress(2, func(n int64) { fmt.Printf("%d records read\n", n) }))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(count, "examples")
+	// Output:
+	// 2 records read
+	// 3 records read
+	// 2 examples
+}
+
+func TestRegistry_ImportTable_streaming(t *testing.T) {
+	table := "1.3.6,dod\n1.3.6.1,internet\nbogus\n2.999,example\n"
+
+	// Buffered imports are atomic.
+	reg := NewRegistry()
+	if err := reg.ImportTable(strings.NewReader(table)); err == nil {
+		t.Fatalf("%s failed: expected error, got nothing", t.Name())
+	} else if reg.Len() != 0 {
+		t.Errorf("%s failed: want 0 records, got %d", t.Name(), reg.Len())
+	}
+
+	// Streaming imports retain records preceding the error.
+	var reports []int64
+	err := reg.ImportTable(strings.NewReader(table), WithStreaming(),
+		WithProgress(1, func(n int64) { reports = append(reports, n) }))
+	if err == nil {
+		t.Fatalf("%s failed: expected error, got nothing", t.Name())
+	} else if reg.Len() != 2 {
+		t.Errorf("%s failed: want 2 records, got %d", t.Name(), reg.Len())
+	} else if len(reports) != 2 || reports[1] != 2 {
+		t.Errorf("%s failed: unexpected progress reports %v", t.Name(), reports)
+	}
+
+	stop := errors.New("stop")
+	var seen int
+	if err = ReadTable(context.Background(), strings.NewReader(table), func(Record) error {
+		if seen++; seen == 2 {
+			return stop
+		}
+		return nil
+	}); err != stop {
+		t.Errorf("%s failed: want callback error, got %v", t.Name(), err)
+	}
+
+	if err = ReadTable(context.Background(), strings.NewReader(table), func(Record) error { return nil },
+		WithProgress(0, func(int64) {})); err == nil {
+		t.Errorf("%s failed: expected error for zero interval, got nothing", t.Name())
+	}
+}
@@ -0,0 +1,57 @@
+package objectid
+
+/*
+transform.go contains generic arc transformation utilities applicable to
+both DotNotation and ASN1Notation values.
+*/
+
+/*
+ArcForm is the type constraint satisfied by the arc types of this package,
+namely [NumberForm] (the arcs of [DotNotation]) and [NameAndNumberForm]
+(the arcs of [ASN1Notation]).
+*/
+type ArcForm interface {
+	NumberForm | NameAndNumberForm
+}
+
+/*
+MapArcs returns a new instance of S, such as [DotNotation] or
+[ASN1Notation], bearing the result of fn for each arc of s, in order.
+The index and value of each arc are passed to fn, allowing concise
+masking and renumbering without index bookkeeping. A nil value is
+returned if s is zero length.
+
+The value passed to fn may share storage with s, and so should not be
+modified in place; fn should return a new value instead. The result is
+not validated: use the Valid method of the result where appropriate.
+*/
+func MapArcs[S ~[]A, A ArcForm](s S, fn func(int, A) A) (out S) {
+	if len(s) > 0 {
+		out = make(S, len(s))
+		for i := 0; i < len(s); i++ {
+			out[i] = fn(i, s[i])
+		}
+	}
+
+	return
+}
+
+/*
+FilterArcs returns a new instance of S, such as [DotNotation] or
+[ASN1Notation], bearing only those arcs of s for which fn returns true,
+in order. The index and value of each arc are passed to fn, allowing
+concise truncation and pruning without index bookkeeping. A nil value is
+returned if no arcs are retained.
+
+As with [MapArcs], the result shares storage with the arcs of s, and is
+not validated.
+*/
+func FilterArcs[S ~[]A, A ArcForm](s S, fn func(int, A) bool) (out S) {
+	for i := 0; i < len(s); i++ {
+		if fn(i, s[i]) {
+			out = append(out, s[i])
+		}
+	}
+
+	return
+}
@@ -0,0 +1,92 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleMapArcs() {
+	dot := mustDot(`1.3.6.1.4.1.56521.999.5`)
+
+	// Mask all arcs beneath the enterprise number.
+	zero, _ := NewNumberForm(0)
+	masked := MapArcs(dot, func(idx int, arc NumberForm) NumberForm {
+		if idx > 6 {
+			return zero
+		}
+		return arc
+	})
+
+	fmt.Println(masked)
+	// Output: 1.3.6.1.4.1.56521.0.0
+}
+
+func ExampleFilterArcs() {
+	asn, _ := NewASN1Notation(`{iso(1) identified-organization(3) dod(6) internet(1) private(4)}`)
+
+	// Truncate to the first three arcs.
+	short := FilterArcs(*asn, func(idx int, _ NameAndNumberForm) bool {
+		return idx < 3
+	})
+
+	fmt.Println(short)
+	// Output: {iso(1) identified-organization(3) dod(6)}
+}
+
+func TestMapArcs(t *testing.T) {
+	dot := mustDot(`2.999.7.2.3`)
+	orig := dot.String()
+
+	one, _ := NewNumberForm(1)
+	renumbered := MapArcs(dot, func(idx int, arc NumberForm) NumberForm {
+		if idx == 2 {
+			return one
+		}
+		nf, _ := NewNumberForm(arc.String())
+		return nf
+	})
+
+	if got := renumbered.String(); got != `2.999.1.2.3` {
+		t.Errorf("%s failed: want 2.999.1.2.3, got %s", t.Name(), got)
+	} else if dot.String() != orig {
+		t.Errorf("%s failed: input modified to %s", t.Name(), dot)
+	}
+
+	renumbered = MapArcs(dot, func(idx int, arc NumberForm) NumberForm {
+		nf, _ := NewNumberForm(idx)
+		return nf
+	})
+	if got := renumbered.String(); got != `0.1.2.3.4` {
+		t.Errorf("%s failed: want 0.1.2.3.4, got %s", t.Name(), got)
+	}
+
+	if got := MapArcs(DotNotation{}, func(int, NumberForm) NumberForm { return one }); got != nil {
+		t.Errorf("%s failed: expected nil result, got %v", t.Name(), got)
+	}
+
+	asn, _ := NewASN1Notation(`{joint-iso-itu-t(2) example(999) 5}`)
+	named := MapArcs(*asn, func(idx int, arc NameAndNumberForm) NameAndNumberForm {
+		if len(arc.Identifier()) == 0 {
+			nanf, _ := NewNameAndNumberForm(`five(5)`)
+			return *nanf
+		}
+		return arc
+	})
+	if got := named.String(); got != `{joint-iso-itu-t(2) example(999) five(5)}` {
+		t.Errorf("%s failed: unexpected result %s", t.Name(), got)
+	}
+}
+
+func TestFilterArcs(t *testing.T) {
+	dot := mustDot(`1.3.6.1.4.1.56521.999.5`)
+
+	even := FilterArcs(dot, func(idx int, _ NumberForm) bool { return idx%2 == 0 })
+	if got := fmt.Sprint(even); got != `1.6.4.56521.5` {
+		t.Errorf("%s failed: want 1.6.4.56521.5, got %s", t.Name(), got)
+	}
+
+	none := FilterArcs(dot, func(int, NumberForm) bool { return false })
+	if none != nil {
+		t.Errorf("%s failed: expected nil result, got %v", t.Name(), none)
+	}
+}
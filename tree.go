@@ -0,0 +1,164 @@
+package objectid
+
+/*
+tree.go contains tree rendering functionality.
+*/
+
+import "sort"
+
+/*
+Tree returns an indented, multi-line rendering of the receiver, in which
+each arc appears upon its own line beneath its parent. Identifiers are
+obtained from the package-wide name dictionary, where known. For example:
+
+	iso(1)
+	  identified-organization(3)
+	    dod(6)
+	      internet(1)
+*/
+func (r DotNotation) Tree() string {
+	return r.ASN().Tree()
+}
+
+/*
+Tree returns an indented, multi-line rendering of the receiver, in which
+each arc appears upon its own line beneath its parent. Arcs which lack an
+identifier are supplemented using the package-wide name dictionary, where
+possible. See [DotNotation.Tree] for an example.
+*/
+func (r ASN1Notation) Tree() string {
+	var lines []string
+	named := r.named()
+	for i := 0; i < len(named); i++ {
+		lines = append(lines, repeat(`  `, i)+named[i].String())
+	}
+
+	return join(lines, "\n")
+}
+
+/*
+MergeTree returns an indented, multi-line rendering of all OIDs within
+oids, alongside an error. Shared prefixes are collapsed, such that each
+arc appears but once beneath its parent, and sibling arcs are ordered
+numerically. Identifiers are taken from the input where present, or else
+from the package-wide name dictionary. For example:
+
+	iso(1)
+	  identified-organization(3)
+	    dod(6)
+	      internet(1)
+	        mgmt(2)
+	        private(4)
+
+Valid input types are string (dot or braced ASN.1 notation), [DotNotation],
+[ASN1Notation] and [OID], as well as pointers to any of these types. An
+error is returned upon the first unsupported or unparsable value.
+*/
+func MergeTree(oids ...any) (s string, err error) {
+	root := new(treeNode)
+	for i := 0; i < len(oids); i++ {
+		var asn ASN1Notation
+		if asn, err = treeInput(oids[i]); err != nil {
+			return
+		}
+
+		node := root
+		asn = asn.named()
+		for j := 0; j < len(asn); j++ {
+			node = node.child(asn[j])
+		}
+	}
+
+	var lines []string
+	root.render(-1, &lines)
+	s = join(lines, "\n")
+
+	return
+}
+
+/*
+treeNode is a single arc within the merged tree produced by [MergeTree].
+*/
+type treeNode struct {
+	nanf NameAndNumberForm
+	kids []*treeNode
+}
+
+/*
+child returns the child of the receiver bearing the number form of nanf,
+creating it if necessary. An identifier is assigned to an existing child
+which lacks one.
+*/
+func (r *treeNode) child(nanf NameAndNumberForm) (kid *treeNode) {
+	for i := 0; i < len(r.kids); i++ {
+		if r.kids[i].nanf.primaryIdentifier.Equal(nanf.primaryIdentifier) {
+			kid = r.kids[i]
+			if len(kid.nanf.identifier) == 0 {
+				kid.nanf.identifier = nanf.identifier
+			}
+			return
+		}
+	}
+
+	kid = &treeNode{nanf: nanf}
+	r.kids = append(r.kids, kid)
+
+	return
+}
+
+func (r *treeNode) render(depth int, lines *[]string) {
+	if depth >= 0 {
+		*lines = append(*lines, repeat(`  `, depth)+r.nanf.String())
+	}
+
+	sort.Slice(r.kids, func(i, j int) bool {
+		return r.kids[i].nanf.primaryIdentifier.Lt(r.kids[j].nanf.primaryIdentifier)
+	})
+
+	for i := 0; i < len(r.kids); i++ {
+		r.kids[i].render(depth+1, lines)
+	}
+}
+
+/*
+treeInput returns an instance of [ASN1Notation] based upon x, which can
+be any input type supported by [MergeTree], alongside an error.
+*/
+func treeInput(x any) (a ASN1Notation, err error) {
+	switch tv := x.(type) {
+	case string:
+		if d, derr := NewDotNotation(tv); derr == nil {
+			a = d.asn()
+		} else if A, aerr := NewASN1Notation(tv); aerr == nil {
+			a = *A
+		} else {
+			err = errorf("Unparsable OID '%s'", tv)
+		}
+	case DotNotation:
+		a = tv.asn()
+	case *DotNotation:
+		if tv != nil {
+			a = tv.asn()
+		}
+	case ASN1Notation:
+		a = tv
+	case *ASN1Notation:
+		if tv != nil {
+			a = *tv
+		}
+	case OID:
+		a = tv.nanf
+	case *OID:
+		if tv != nil {
+			a = tv.nanf
+		}
+	default:
+		err = errorf("Unsupported %T input type: %#v", x, x)
+	}
+
+	if err == nil && a.Len() == 0 {
+		err = errorf("Zero length OID in %T input", x)
+	}
+
+	return
+}
@@ -0,0 +1,76 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleDotNotation_Tree() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	fmt.Println(dot.Tree())
+	// Output:
+	// iso(1)
+	//   identified-organization(3)
+	//     dod(6)
+	//       internet(1)
+	//         private(4)
+	//           enterprise(1)
+	//             56521
+}
+
+func TestASN1Notation_Tree(t *testing.T) {
+	asn, _ := NewASN1Notation(`{iso(1) org(3) 6 1 4 1 56521 999}`)
+	want := "iso(1)\n  org(3)\n    dod(6)\n      internet(1)\n        private(4)\n          enterprise(1)\n            56521\n              999"
+	if got := asn.Tree(); got != want {
+		t.Errorf("%s failed:\nwant:\n%s\ngot:\n%s", t.Name(), want, got)
+	}
+
+	var zero ASN1Notation
+	if got := zero.Tree(); got != `` {
+		t.Errorf("%s failed: unexpected output for zero %T: %s", t.Name(), zero, got)
+	}
+}
+
+func ExampleMergeTree() {
+	tree, err := MergeTree(`1.3.6.1.4.1`, `1.3.6.1.2.1`, `1.3.6.1.2`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(tree)
+	// Output:
+	// iso(1)
+	//   identified-organization(3)
+	//     dod(6)
+	//       internet(1)
+	//         mgmt(2)
+	//           mib-2(1)
+	//         private(4)
+	//           enterprise(1)
+}
+
+func TestMergeTree(t *testing.T) {
+	dot, _ := NewDotNotation(`2.999.10`)
+	asn, _ := NewASN1Notation(`{joint-iso-itu-t(2) example(999) widget(2)}`)
+	oid, _ := NewOID(`{joint-iso-itu-t(2) example(999) 9 gadget(1)}`)
+
+	got, err := MergeTree(dot, asn, oid, `{2 999 9}`, `1.2`)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	want := "iso(1)\n  member-body(2)\njoint-iso-itu-t(2)\n  example(999)\n    widget(2)\n    9\n      gadget(1)\n    10"
+	if got != want {
+		t.Errorf("%s failed:\nwant:\n%s\ngot:\n%s", t.Name(), want, got)
+	}
+
+	for _, bogus := range []any{`bogus`, 1.5, (*DotNotation)(nil), DotNotation{}} {
+		if _, err := MergeTree(bogus); err == nil {
+			t.Errorf("%s failed: no error for %T input", t.Name(), bogus)
+		}
+	}
+
+	if got, err := MergeTree(); err != nil || got != `` {
+		t.Errorf("%s failed: unexpected output for empty input: '%s' (%v)", t.Name(), got, err)
+	}
+}
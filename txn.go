@@ -0,0 +1,276 @@
+package objectid
+
+/*
+txn.go implements atomic, multi-record mutation of Registry instances.
+*/
+
+/*
+MutationOp describes the operation performed by a single [Mutation].
+*/
+type MutationOp uint8
+
+const (
+	MutationRegister   MutationOp = iota // add or replace a record, as with Registry.Register
+	MutationCreate                       // add a record, which must not already exist
+	MutationUnregister                   // remove a record, which must exist
+)
+
+var mutationOps = [...]string{
+	MutationRegister:   `register`,
+	MutationCreate:     `create`,
+	MutationUnregister: `unregister`,
+}
+
+/*
+String returns the string representation of the receiver (e.g.:
+"register"), or a zero string if the receiver is invalid.
+*/
+func (r MutationOp) String() (s string) {
+	if int(r) < len(mutationOps) {
+		s = mutationOps[r]
+	}
+
+	return
+}
+
+/*
+Mutation is a single operation submitted to [Registry.Apply]. Only the
+Dot field of Record is consulted for [MutationUnregister].
+*/
+type Mutation struct {
+	Op     MutationOp
+	Record Record
+}
+
+/*
+MutationConflict describes a single [Mutation] which could not be
+performed by [Registry.Apply].
+*/
+type MutationConflict struct {
+	// Index contains the index of the offending mutation.
+	Index int
+
+	// Mutation contains the offending mutation.
+	Mutation Mutation
+
+	// Err contains the reason for the conflict.
+	Err error
+}
+
+/*
+ApplyError is returned by [Registry.Apply] when one (1) or more mutations
+could not be performed, and contains a [MutationConflict] for each, in
+the order in which the mutations were submitted.
+*/
+type ApplyError []MutationConflict
+
+/*
+Error returns the string representation of the receiver.
+*/
+func (r ApplyError) Error() string {
+	if len(r) == 0 {
+		return `No mutation conflicts`
+	}
+
+	c := r[0]
+	msg := sprintf("Mutation %d (%s %s): %v", c.Index, c.Mutation.Op, c.Mutation.Record.Dot, c.Err)
+	if len(r) > 1 {
+		msg += sprintf(" (and %d more conflicts)", len(r)-1)
+	}
+
+	return msg
+}
+
+/*
+Unwrap returns the errors of each [MutationConflict] within the receiver.
+*/
+func (r ApplyError) Unwrap() []error {
+	errs := make([]error, len(r))
+	for i := 0; i < len(r); i++ {
+		errs[i] = r[i].Err
+	}
+
+	return errs
+}
+
+/*
+undoStep records the entry held at dot prior to a single mutation, such
+that the mutation may be reversed. A nil entry denotes the absence of a
+record.
+*/
+type undoStep struct {
+	dot   DotNotation
+	entry *regEntry
+}
+
+/*
+Apply performs each of muts upon the receiver as a single atomic
+operation: either all mutations succeed, or the receiver is left
+untouched. This is useful for large imports which must not leave a
+registry partially populated.
+
+Mutations are performed in order, such that each observes the effect
+of those preceding it, including the [Constraint] checks of
+[Registry.Register]. Should any mutation fail, the remaining mutations
+are nonetheless evaluated, such that every conflict is reported within
+the returned [ApplyError], after which all changes are rolled back.
+*/
+func (r *Registry) Apply(muts []Mutation) (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, err = r.apply(muts)
+
+	return
+}
+
+/*
+apply performs muts upon the receiver in the manner of [Registry.Apply],
+returning the undoStep instances which reverse a successful batch, in the
+order in which they were recorded. The caller must hold the write lock.
+*/
+func (r *Registry) apply(muts []Mutation) (undo []undoStep, err error) {
+	var conflicts ApplyError
+
+	for i := 0; i < len(muts); i++ {
+		var step undoStep
+		if step, err = r.mutate(muts[i]); err != nil {
+			conflicts = append(conflicts, MutationConflict{Index: i, Mutation: muts[i], Err: err})
+			continue
+		}
+		undo = append(undo, step)
+	}
+
+	if err = nil; len(conflicts) > 0 {
+		r.revert(undo)
+		undo, err = nil, conflicts
+	}
+
+	return
+}
+
+/*
+revert reverses each of undo, in the reverse order of their recording.
+The caller must hold the write lock.
+*/
+func (r *Registry) revert(undo []undoStep) {
+	for i := len(undo) - 1; i >= 0; i-- {
+		r.restore(undo[i])
+	}
+}
+
+/*
+mutate performs mut upon the receiver, returning the undoStep which
+reverses it alongside an error. The caller must hold the write lock.
+*/
+func (r *Registry) mutate(mut Mutation) (step undoStep, err error) {
+	rec := mut.Record
+	switch mut.Op {
+	case MutationRegister, MutationCreate:
+		if err = rec.validate(); err != nil {
+			return
+		}
+		node := r.root.find(rec.Dot)
+		if node != nil && mut.Op == MutationCreate {
+			err = errorf("Record already exists for %s", rec.Dot)
+			return
+		} else if err = r.checkChild(rec.Dot); err != nil {
+			return
+		}
+		step.dot = rec.Dot.clone()
+		if node != nil {
+			step.entry = node.entry
+		}
+		r.store(rec)
+	case MutationUnregister:
+		d, ok := registryDot(rec.Dot)
+		if !ok {
+			err = errorf("Invalid %T for unregistration: %s", d, rec.Dot)
+			return
+		}
+		node := r.root.find(d)
+		if node == nil {
+			err = notFound(d.String())
+			return
+		}
+		step.dot, step.entry = d.clone(), node.entry
+		r.root.remove(d)
+		r.count--
+	default:
+		err = errorf("Unknown %T (%d)", mut.Op, mut.Op)
+	}
+
+	return
+}
+
+/*
+restore reverses the mutation described by step. The caller must hold
+the write lock.
+*/
+func (r *Registry) restore(step undoStep) {
+	if step.entry == nil {
+		if r.root.remove(step.dot) {
+			r.count--
+		}
+	} else if r.root.insert(step.dot, step.entry) {
+		r.count++
+	}
+}
+
+/*
+storeAll stores each of recs within the receiver, subject to the
+[Constraint] checks of [Registry.Register]. Should any record be denied,
+all records stored thus far are reverted and the error is returned. The
+caller must hold the write lock.
+*/
+func (r *Registry) storeAll(recs []Record) (err error) {
+	undo := make([]undoStep, 0, len(recs))
+	for i := 0; i < len(recs); i++ {
+		var step undoStep
+		if step, err = r.mutate(Mutation{Op: MutationRegister, Record: recs[i]}); err != nil {
+			r.revert(undo)
+			return
+		}
+		undo = append(undo, step)
+	}
+
+	return
+}
+
+/*
+Apply performs muts upon the receiver in the manner of [Registry.Apply],
+appending the batch to the log as a single entry bearing a single
+checksum, such that a crash cannot leave only part of the batch on disk.
+The entry is committed to stable storage before the underlying [Registry]
+is modified; should the write fail, the registry is left untouched.
+*/
+func (r *RegistryFile) Apply(muts []Mutation) (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.f == nil {
+		err = errorf("%T is closed", r)
+		return
+	}
+
+	r.reg.mu.Lock()
+	defer r.reg.mu.Unlock()
+
+	// Evaluate the batch without retaining its effect, such that
+	// conflicts are reported before anything is written.
+	var undo []undoStep
+	if undo, err = r.reg.apply(muts); err != nil {
+		return
+	}
+	r.reg.revert(undo)
+
+	if payload := logBatchPayload(muts); uint64(len(payload)) > maxLogBatchLength {
+		err = errorf("Batch of %d mutations exceeds maximum log entry length", len(muts))
+	} else if err = r.commit(appendLogFrame(nil, logBatch, payload)); err == nil {
+		// The write lock has been held throughout, so the batch
+		// evaluated above cannot fail now.
+		_, err = r.reg.apply(muts)
+	}
+
+	return
+}
@@ -0,0 +1,168 @@
+package objectid
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func ExampleRegistry_Apply() {
+	reg := NewRegistry()
+	_ = reg.Register(Record{Dot: mustDot(`2.999.1`), Identifier: `existing`})
+
+	err := reg.Apply([]Mutation{
+		{Op: MutationCreate, Record: Record{Dot: mustDot(`2.999.2`)}},
+		{Op: MutationCreate, Record: Record{Dot: mustDot(`2.999.1`)}},
+		{Op: MutationUnregister, Record: Record{Dot: mustDot(`2.999.3`)}},
+	})
+
+	var conflicts ApplyError
+	if errors.As(err, &conflicts) {
+		for _, c := range conflicts {
+			fmt.Printf("%d: %v\n", c.Index, c.Err)
+		}
+	}
+	fmt.Println(reg.Len())
+	// Output:
+	// 1: Record already exists for 2.999.1
+	// 2: 2.999.3: OID not found
+	// 1
+}
+
+func TestRegistry_Apply(t *testing.T) {
+	reg := newTestRegistry(t)
+	want := reg.Records()
+
+	if err := reg.SetConstraint(`2.999`, Constraint{MaxChildren: 1}); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	// A failed batch must leave the registry untouched, including
+	// records replaced or removed by mutations preceding the conflict.
+	err := reg.Apply([]Mutation{
+		{Op: MutationRegister, Record: Record{Dot: want[0].Dot, Identifier: `replaced`}},
+		{Op: MutationUnregister, Record: Record{Dot: want[1].Dot}},
+		{Op: MutationRegister, Record: Record{Dot: mustDot(`2.999.1`)}},
+		{Op: MutationRegister, Record: Record{Dot: mustDot(`2.999.2`)}},
+		{Op: MutationRegister, Record: Record{}},
+		{Op: MutationOp(99), Record: Record{Dot: mustDot(`2.999.3`)}},
+	})
+
+	var conflicts ApplyError
+	if !errors.As(err, &conflicts) {
+		t.Fatalf("%s failed: want %T, got %v", t.Name(), conflicts, err)
+	} else if len(conflicts) != 3 || conflicts[0].Index != 3 || conflicts[1].Index != 4 || conflicts[2].Index != 5 {
+		t.Errorf("%s failed: unexpected conflicts %v", t.Name(), conflicts)
+	}
+
+	if got := reg.Records(); fmt.Sprint(got) != fmt.Sprint(want) || reg.Len() != len(want) {
+		t.Errorf("%s failed: registry modified by failed batch:\n\twant: %v\n\tgot:  %v", t.Name(), want, got)
+	}
+
+	if err = reg.Apply([]Mutation{
+		{Op: MutationRegister, Record: Record{Dot: want[0].Dot, Identifier: `replaced`}},
+		{Op: MutationUnregister, Record: Record{Dot: want[1].Dot}},
+		{Op: MutationCreate, Record: Record{Dot: mustDot(`2.999.1`)}},
+	}); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	if rec, _ := reg.Lookup(want[0].Dot); rec.Identifier != `replaced` {
+		t.Errorf("%s failed: want identifier 'replaced', got '%s'", t.Name(), rec.Identifier)
+	} else if _, found := reg.Lookup(want[1].Dot); found {
+		t.Errorf("%s failed: %s was not unregistered", t.Name(), want[1].Dot)
+	} else if reg.Len() != len(want) {
+		t.Errorf("%s failed: want %d records, got %d", t.Name(), len(want), reg.Len())
+	}
+}
+
+func TestRegistryFile_Apply(t *testing.T) {
+	path := filepath.Join(t.TempDir(), `reg.log`)
+	rf, err := OpenRegistryFile(path)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	if err = rf.Apply([]Mutation{
+		{Op: MutationCreate, Record: Record{Dot: mustDot(`2.999.1`), Identifier: `one`}},
+		{Op: MutationCreate, Record: Record{Dot: mustDot(`2.999.2`)}},
+		{Op: MutationUnregister, Record: Record{Dot: mustDot(`2.999.2`)}},
+	}); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if err = rf.Apply([]Mutation{
+		{Op: MutationCreate, Record: Record{Dot: mustDot(`2.999.3`)}},
+		{Op: MutationCreate, Record: Record{Dot: mustDot(`2.999.1`)}},
+	}); err == nil {
+		t.Fatalf("%s failed: expected conflict", t.Name())
+	} else if err = rf.Close(); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	if rf, err = OpenRegistryFile(path); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+	defer rf.Close()
+
+	recs := rf.Registry().Records()
+	if len(recs) != 1 || recs[0].Dot.String() != `2.999.1` || recs[0].Identifier != `one` {
+		t.Errorf("%s failed: unexpected records after replay: %v", t.Name(), recs)
+	}
+
+	// A failed log write must leave the registry untouched.
+	rf.f.Close()
+	rf.bw = bufio.NewWriterSize(rf.f, 16)
+	if err = rf.Apply([]Mutation{
+		{Op: MutationCreate, Record: Record{Dot: mustDot(`2.999.4`), Identifier: `four`}},
+		{Op: MutationUnregister, Record: Record{Dot: mustDot(`2.999.1`)}},
+	}); err == nil {
+		t.Errorf("%s failed: expected write error, got nothing", t.Name())
+	} else if got := rf.Registry().Records(); fmt.Sprint(got) != fmt.Sprint(recs) {
+		t.Errorf("%s failed: registry modified by failed write:\n\twant: %v\n\tgot:  %v", t.Name(), recs, got)
+	}
+}
+
+func TestRegistryFile_Apply_torn(t *testing.T) {
+	path := filepath.Join(t.TempDir(), `reg.log`)
+	rf, err := OpenRegistryFile(path)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	if err = rf.Register(Record{Dot: mustDot(`2.999.9`)}); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if err = rf.Apply([]Mutation{
+		{Op: MutationCreate, Record: Record{Dot: mustDot(`2.999.1`)}},
+		{Op: MutationCreate, Record: Record{Dot: mustDot(`2.999.2`)}},
+		{Op: MutationUnregister, Record: Record{Dot: mustDot(`2.999.9`)}},
+	}); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	// Entries are committed without an explicit flush.
+	if rf2, err := OpenRegistryFile(path); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if rf2.Registry().Len() != 2 {
+		t.Errorf("%s failed: want 2 records before close, got %d", t.Name(), rf2.Registry().Len())
+	}
+	rf.Close()
+
+	// A batch torn at any point must be discarded in its entirety.
+	info, _ := os.Stat(path)
+	for _, cut := range []int64{1, 8, 20} {
+		b, _ := os.ReadFile(path)
+		torn := filepath.Join(t.TempDir(), `torn.log`)
+		_ = os.WriteFile(torn, b[:info.Size()-cut], 0o644)
+
+		if rf, err = OpenRegistryFile(torn); err != nil {
+			t.Fatalf("%s failed: %v", t.Name(), err)
+		}
+		recs := rf.Registry().Records()
+		if len(recs) != 1 || recs[0].Dot.String() != `2.999.9` {
+			t.Errorf("%s failed: partial batch replayed after cut of %d: %v", t.Name(), cut, recs)
+		}
+		rf.Close()
+	}
+}
@@ -0,0 +1,129 @@
+package objectid
+
+/*
+uuid.go contains UUID-based (2.25) OID derivation, per ITU-T Rec. X.667.
+*/
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"math/big"
+)
+
+/*
+Name space UUIDs defined in Appendix C of RFC 4122, for use with the
+[NewUUIDv5DotNotation] function.
+*/
+var (
+	UUIDNamespaceDNS  = [16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	UUIDNamespaceURL  = [16]byte{0x6b, 0xa7, 0xb8, 0x11, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	UUIDNamespaceOID  = [16]byte{0x6b, 0xa7, 0xb8, 0x12, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	UUIDNamespaceX500 = [16]byte{0x6b, 0xa7, 0xb8, 0x14, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+)
+
+/*
+NewUUIDv5DotNotation returns an instance of *[DotNotation] beneath the
+2.25 arc, derived from namespace and name using the name-based (SHA-1,
+version 5) UUID construction of ITU-T Rec. X.667 and RFC 4122, alongside
+an error. Identical input always yields the same OID, making this useful
+for minting deterministic, globally unique OIDs from stable names.
+
+Valid namespace input types are:
+
+  - [16]byte or []byte (length 16), bearing a UUID in network byte order
+  - string, bearing a UUID in hexadecimal form (e.g.: "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+  - string, [DotNotation] or *[DotNotation], bearing an OID
+
+When an OID is provided as the namespace, the namespace UUID is itself
+derived from the dot notation of the OID beneath [UUIDNamespaceOID].
+*/
+func NewUUIDv5DotNotation(namespace any, name string) (r *DotNotation, err error) {
+	var ns [16]byte
+	if ns, err = assertUUIDNamespace(namespace); err != nil {
+		return
+	}
+
+	uuid := uuidv5(ns, name)
+	arc := NumberForm(*big.NewInt(0).SetBytes(uuid[:]))
+	r = &DotNotation{
+		NumberForm(*big.NewInt(2)),
+		NumberForm(*big.NewInt(25)),
+		arc,
+	}
+
+	return
+}
+
+/*
+uuidv5 returns the version 5 UUID derived from ns and name.
+*/
+func uuidv5(ns [16]byte, name string) (uuid [16]byte) {
+	h := sha1.New()
+	h.Write(ns[:])
+	h.Write([]byte(name))
+	copy(uuid[:], h.Sum(nil))
+
+	uuid[6] = uuid[6]&0x0F | 0x50 // version 5
+	uuid[8] = uuid[8]&0x3F | 0x80 // RFC 4122 variant
+
+	return
+}
+
+/*
+assertUUIDNamespace returns the namespace UUID described by x, alongside
+an error. See [NewUUIDv5DotNotation] for valid input types.
+*/
+func assertUUIDNamespace(x any) (ns [16]byte, err error) {
+	switch tv := x.(type) {
+	case [16]byte:
+		ns = tv
+	case []byte:
+		if len(tv) != 16 {
+			err = errorf("UUID namespace must be 16 bytes; found %d", len(tv))
+			break
+		}
+		copy(ns[:], tv)
+	case string:
+		if len(tv) == 36 && contains(tv, `-`) {
+			ns, err = parseUUID(tv)
+			break
+		}
+
+		var d *DotNotation
+		if d, err = NewDotNotation(tv); err == nil {
+			ns = uuidv5(UUIDNamespaceOID, d.String())
+		}
+	case DotNotation:
+		ns, err = assertUUIDNamespace(&tv)
+	case *DotNotation:
+		if tv == nil {
+			err = errorf("Nil %T UUID namespace", tv)
+		} else if err = tv.Validate(); err == nil {
+			ns = uuidv5(UUIDNamespaceOID, tv.String())
+		}
+	default:
+		err = errorf("Unsupported %T UUID namespace type", tv)
+	}
+
+	return
+}
+
+/*
+parseUUID returns the UUID parsed from the hexadecimal form s (e.g.:
+"6ba7b810-9dad-11d1-80b4-00c04fd430c8"), alongside an error.
+*/
+func parseUUID(s string) (uuid [16]byte, err error) {
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		err = errorf("Invalid UUID '%s'", s)
+		return
+	}
+
+	var b []byte
+	if b, err = hex.DecodeString(s[:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]); err != nil {
+		err = errorf("Invalid UUID '%s': %v", s, err)
+		return
+	}
+	copy(uuid[:], b)
+
+	return
+}
@@ -0,0 +1,53 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleNewUUIDv5DotNotation() {
+	dot, err := NewUUIDv5DotNotation(UUIDNamespaceDNS, `www.example.com`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(dot)
+	// Output: 2.25.62257697832880430461588949038000940706
+}
+
+func TestNewUUIDv5DotNotation(t *testing.T) {
+	const want = `2.25.109201512816846467673016631463198102571`
+	d, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	for _, ns := range []any{
+		`1.3.6.1.4.1.56521`,
+		*d,
+		d,
+		`4212f2a4-1722-58bb-b712-6f6409bc7dbe`,
+		[]byte{0x42, 0x12, 0xf2, 0xa4, 0x17, 0x22, 0x58, 0xbb, 0xb7, 0x12, 0x6f, 0x64, 0x09, 0xbc, 0x7d, 0xbe},
+	} {
+		dot, err := NewUUIDv5DotNotation(ns, `widget`)
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if dot.String() != want {
+			t.Errorf("%s failed for %T: want '%s', got '%s'", t.Name(), ns, want, dot)
+		} else if !dot.Valid() {
+			t.Errorf("%s failed: result is invalid", t.Name())
+		}
+	}
+
+	var nilDot *DotNotation
+	for _, bogus := range []any{
+		`4212f2a4x1722-58bb-b712-6f6409bc7dbe`,
+		`4212f2a4-1722-58bb-b712-6f6409bc7dbZ`,
+		`3.1`,
+		[]byte{0x01},
+		nilDot,
+		DotNotation{},
+		3.14,
+	} {
+		if _, err := NewUUIDv5DotNotation(bogus, `widget`); err == nil {
+			t.Errorf("%s failed: bogus namespace %v accepted", t.Name(), bogus)
+		}
+	}
+}
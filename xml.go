@@ -0,0 +1,117 @@
+package objectid
+
+/*
+xml.go implements XML marshaling of DotNotation and OID values.
+*/
+
+import "encoding/xml"
+
+/*
+MarshalXML implements the [encoding/xml.Marshaler] interface, encoding the
+receiver as an element bearing its dot notation string form (e.g.:
+"<Algorithm>1.3.6.1</Algorithm>"). A zero receiver yields an empty element.
+*/
+func (r DotNotation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(r.xmlText(), start)
+}
+
+/*
+UnmarshalXML implements the [encoding/xml.Unmarshaler] interface, setting
+the receiver to the OID described by the character data of the element,
+which is read in the manner of [NewDotNotation] following the removal of
+surrounding whitespace. An empty element yields a zero receiver. The
+receiver is left unmodified upon error.
+*/
+func (r *DotNotation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) (err error) {
+	var s string
+	if err = d.DecodeElement(&s, &start); err == nil {
+		err = r.unmarshalXMLText(s)
+	}
+
+	return
+}
+
+/*
+MarshalXMLAttr implements the [encoding/xml.MarshalerAttr] interface,
+encoding the receiver as an attribute bearing its dot notation string
+form. A zero receiver yields no attribute.
+*/
+func (r DotNotation) MarshalXMLAttr(name xml.Name) (attr xml.Attr, err error) {
+	if r.Len() > 0 {
+		attr = xml.Attr{Name: name, Value: r.xmlText()}
+	}
+
+	return
+}
+
+/*
+UnmarshalXMLAttr implements the [encoding/xml.UnmarshalerAttr] interface
+in the manner of [DotNotation.UnmarshalXML].
+*/
+func (r *DotNotation) UnmarshalXMLAttr(attr xml.Attr) error {
+	return r.unmarshalXMLText(attr.Value)
+}
+
+func (r DotNotation) xmlText() (s string) {
+	if r.Len() > 0 {
+		s = r.String()
+	}
+
+	return
+}
+
+func (r *DotNotation) unmarshalXMLText(s string) (err error) {
+	if s = trimS(s); len(s) == 0 {
+		*r = nil
+		return
+	}
+
+	var d *DotNotation
+	if d, err = NewDotNotation(s); err == nil {
+		*r = *d
+	}
+
+	return
+}
+
+/*
+MarshalXML implements the [encoding/xml.Marshaler] interface, encoding the
+receiver as an element bearing its dot notation string form, as with
+[DotNotation.MarshalXML]. Names are not encoded; use [OID.MarshalText]
+where the ASN.1 notation is required.
+*/
+func (r OID) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(r.nanf.arcs().xmlText(), start)
+}
+
+/*
+UnmarshalXML implements the [encoding/xml.Unmarshaler] interface, setting
+the receiver to the OID described by the character data of the element,
+which may bear any notation accepted by [OID.UnmarshalText]. An empty
+element yields a zero receiver. The receiver is left unmodified upon error.
+*/
+func (r *OID) UnmarshalXML(d *xml.Decoder, start xml.StartElement) (err error) {
+	var s string
+	if err = d.DecodeElement(&s, &start); err == nil {
+		err = r.UnmarshalText([]byte(s))
+	}
+
+	return
+}
+
+/*
+MarshalXMLAttr implements the [encoding/xml.MarshalerAttr] interface,
+encoding the receiver as an attribute bearing its dot notation string
+form. A zero receiver yields no attribute.
+*/
+func (r OID) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return r.nanf.arcs().MarshalXMLAttr(name)
+}
+
+/*
+UnmarshalXMLAttr implements the [encoding/xml.UnmarshalerAttr] interface
+in the manner of [OID.UnmarshalXML].
+*/
+func (r *OID) UnmarshalXMLAttr(attr xml.Attr) error {
+	return r.UnmarshalText([]byte(attr.Value))
+}
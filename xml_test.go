@@ -0,0 +1,79 @@
+package objectid
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+)
+
+func ExampleDotNotation_MarshalXML() {
+	type method struct {
+		XMLName   xml.Name    `xml:"SignatureMethod"`
+		Algorithm DotNotation `xml:"Algorithm,attr"`
+		Policy    DotNotation `xml:"SigPolicyId"`
+	}
+
+	b, _ := xml.Marshal(method{
+		Algorithm: mustDot(`1.2.840.10045.4.3.2`),
+		Policy:    mustDot(`2.16.724.1.3.1.1.2.1.9`),
+	})
+	fmt.Println(string(b))
+	// Output: <SignatureMethod Algorithm="1.2.840.10045.4.3.2"><SigPolicyId>2.16.724.1.3.1.1.2.1.9</SigPolicyId></SignatureMethod>
+}
+
+func TestXML(t *testing.T) {
+	type doc struct {
+		XMLName xml.Name    `xml:"doc"`
+		Attr    DotNotation `xml:"attr,attr,omitempty"`
+		Elem    DotNotation `xml:"elem,omitempty"`
+		OIDAttr OID         `xml:"oid,attr"`
+		OIDElem OID         `xml:"oidElem"`
+	}
+
+	o, _ := NewOID(`{joint-iso-itu-t(2) example(999) test(1)}`)
+	want := doc{
+		Attr:    mustDot(`1.3.6.1`),
+		Elem:    mustDot(`2.25.987895962269883002155146617097157934`),
+		OIDAttr: *o,
+		OIDElem: *o,
+	}
+
+	b, err := xml.Marshal(want)
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	const raw = `<doc attr="1.3.6.1" oid="2.999.1"><elem>2.25.987895962269883002155146617097157934</elem><oidElem>2.999.1</oidElem></doc>`
+	if string(b) != raw {
+		t.Errorf("%s failed:\n\twant: %s\n\tgot:  %s", t.Name(), raw, b)
+	}
+
+	var got doc
+	if err = xml.Unmarshal([]byte(`<doc attr=" 1.3.6.1 " oid="2.999.1"><elem>
+		2.25.987895962269883002155146617097157934
+	</elem><oidElem>{joint-iso-itu-t(2) example(999) test(1)}</oidElem></doc>`), &got); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if fmt.Sprint(got.Attr, got.Elem) != fmt.Sprint(want.Attr, want.Elem) {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), want, got)
+	} else if got.OIDAttr.Dot().String() != `2.999.1` || got.OIDElem.Leaf().Identifier() != `test` {
+		t.Errorf("%s failed: unexpected OIDs %v, %v", t.Name(), got.OIDAttr.ASN(), got.OIDElem.ASN())
+	}
+
+	// Zero values are omitted or left empty.
+	if b, err = xml.Marshal(doc{}); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if string(b) != `<doc><oidElem></oidElem></doc>` {
+		t.Errorf("%s failed: unexpected zero encoding %s", t.Name(), b)
+	}
+
+	for _, bad := range []string{
+		`<doc attr="3.1"></doc>`,
+		`<doc><elem>1.3.x</elem></doc>`,
+		`<doc oid="{3 1}"></doc>`,
+		`<doc><oidElem>bogus</oidElem></doc>`,
+	} {
+		if err = xml.Unmarshal([]byte(bad), &got); err == nil {
+			t.Errorf("%s failed: expected error for %s", t.Name(), bad)
+		}
+	}
+}